@@ -43,8 +43,8 @@ func BenchmarkArrayLiteral_StringWithHash(b *testing.B) {
 		&FloatLiteral{Token: token.Token{Type: token.INT, Literal: "2.2"}, Value: 2.2},
 		&StringLiteral{Token: token.Token{Type: token.STRING, Literal: "3"}, Value: "3"},
 		&Boolean{Token: token.Token{Type: token.TRUE, Literal: "True"}, Value: true},
-		&HashLiteral{Pairs: map[Expression]Expression{
-			&IntegerLiteral{Token: token.Token{Type: token.LBRACKET, Literal: "1"}, Value: 1}: &Boolean{Token: token.Token{Type: token.TRUE, Literal: "True"}, Value: true},
+		&HashLiteral{Pairs: []HashLiteralPair{
+			{Key: &IntegerLiteral{Token: token.Token{Type: token.LBRACKET, Literal: "1"}, Value: 1}, Value: &Boolean{Token: token.Token{Type: token.TRUE, Literal: "True"}, Value: true}},
 		}},
 	}
 	arrLiteral := &ArrayLiteral{Token: token.Token{Type: token.LBRACKET, Literal: "["}, Elements: elements}
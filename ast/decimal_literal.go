@@ -0,0 +1,19 @@
+package ast
+
+import (
+	"github.com/gravataLonga/ninja/token"
+	"math/big"
+)
+
+// DecimalLiteral is an exact decimal, e.g.: 12.30d is Value 1230 and Scale 2
+type DecimalLiteral struct {
+	Token token.Token
+	Value *big.Int
+	Scale int32
+}
+
+func (dl *DecimalLiteral) expressionNode()      {}
+func (dl *DecimalLiteral) TokenLiteral() string { return dl.Token.Literal }
+func (dl *DecimalLiteral) String() string {
+	return dl.TokenLiteral()
+}
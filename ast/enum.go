@@ -5,9 +5,39 @@ import (
 	"strings"
 )
 
+// EnumCase is a case declared on enum, it can be a plain case, a case with constant
+// value (case OK: 200) or a case with associated data (case Circle(radius)).
+type EnumCase struct {
+	Token      token.Token // the case identifier token
+	Name       string
+	Value      Expression
+	Parameters []*Identifier
+}
+
+func (ec *EnumCase) String() string {
+	out := strings.Builder{}
+	out.WriteString(ec.Name)
+	if ec.Parameters != nil {
+		params := make([]string, len(ec.Parameters))
+		for i, p := range ec.Parameters {
+			params[i] = p.String()
+		}
+		out.WriteString("(")
+		out.WriteString(strings.Join(params, ", "))
+		out.WriteString(")")
+	}
+	if ec.Value != nil {
+		out.WriteString(":")
+		out.WriteString(ec.Value.String())
+	}
+	return out.String()
+}
+
 type EnumStatement struct {
 	Token      token.Token
-	Branches   map[string]Expression
+	Branches   map[string]Expression // constant value of each case, if declared
+	Cases      []*EnumCase           // all cases by declaration order
+	Methods    []*FunctionLiteral
 	Identifier Expression
 }
 
@@ -17,15 +47,12 @@ func (e *EnumStatement) String() string {
 	out := strings.Builder{}
 	out.WriteString("enum")
 	out.WriteString(e.Identifier.String())
-	branches := make([]string, len(e.Branches))
-	i := 0
-	for name, v := range e.Branches {
-		br := strings.Builder{}
-		br.WriteString(name)
-		br.WriteString(":")
-		br.WriteString(v.String())
-		branches[i] = br.String()
-		i++
+	branches := make([]string, 0, len(e.Cases)+len(e.Methods))
+	for _, c := range e.Cases {
+		branches = append(branches, c.String())
+	}
+	for _, m := range e.Methods {
+		branches = append(branches, m.String())
 	}
 	out.WriteString("{")
 	out.WriteString(strings.Join(branches, ";"))
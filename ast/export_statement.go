@@ -0,0 +1,46 @@
+package ast
+
+import (
+	"github.com/gravataLonga/ninja/token"
+)
+
+// ExportStatement make a declaration visible to modules which import it,
+// e.g.: export function add(a, b) { return a + b; }
+type ExportStatement struct {
+	Token     token.Token // the token.EXPORT token
+	Statement Statement
+}
+
+func (es *ExportStatement) statementNode()       {}
+func (es *ExportStatement) TokenLiteral() string { return es.Token.Literal }
+func (es *ExportStatement) String() string {
+	return es.TokenLiteral() + " " + es.Statement.String()
+}
+
+// Name get identifier declared by exported statement, it returns nil when
+// statement isn't a declaration.
+func (es *ExportStatement) Name() *Identifier {
+	switch stmt := es.Statement.(type) {
+	case *VarStatement:
+		if stmt != nil {
+			return stmt.Name
+		}
+	case *ConstStatement:
+		if stmt != nil {
+			return stmt.Name
+		}
+	case *EnumStatement:
+		if stmt != nil {
+			ident, _ := stmt.Identifier.(*Identifier)
+			return ident
+		}
+	case *ExpressionStatement:
+		if stmt == nil {
+			return nil
+		}
+		if fn, ok := stmt.Expression.(*FunctionLiteral); ok && fn != nil {
+			return fn.Name
+		}
+	}
+	return nil
+}
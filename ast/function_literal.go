@@ -11,6 +11,7 @@ type FunctionLiteral struct {
 	Parameters []Expression
 	Body       *BlockStatement
 	Name       *Identifier
+	ReturnType *TypeAnnotation
 }
 
 func (fl *FunctionLiteral) expressionNode()      {}
@@ -19,7 +20,7 @@ func (fl *FunctionLiteral) String() string {
 	var out bytes.Buffer
 	params := make([]string, len(fl.Parameters))
 	for i, p := range fl.Parameters {
-		params[i] = p.String()
+		params[i] = parameterDeclaration(p)
 	}
 	out.WriteString(fl.TokenLiteral())
 	if fl.Name != nil {
@@ -28,8 +29,27 @@ func (fl *FunctionLiteral) String() string {
 	}
 	out.WriteString("(")
 	out.WriteString(strings.Join(params, ", "))
-	out.WriteString(") {")
+	out.WriteString(")")
+	if fl.ReturnType != nil {
+		out.WriteString(": ")
+		out.WriteString(fl.ReturnType.String())
+	}
+	out.WriteString(" {")
 	out.WriteString(fl.Body.String())
 	out.WriteString("}")
 	return out.String()
 }
+
+// parameterDeclaration print parameter with it is type annotation, parameters
+// can be an identifier or an infix expression when they have a default value.
+func parameterDeclaration(p Expression) string {
+	switch p := p.(type) {
+	case *Identifier:
+		return p.Declaration()
+	case *InfixExpression:
+		if ident, ok := p.Left.(*Identifier); ok && ident.Type != nil {
+			return "(" + ident.Declaration() + " " + p.Operator + " " + p.Right.String() + ")"
+		}
+	}
+	return p.String()
+}
@@ -6,9 +6,15 @@ import (
 	"strings"
 )
 
+// HashLiteralPair is a key and value written on a hash literal
+type HashLiteralPair struct {
+	Key   Expression
+	Value Expression
+}
+
 type HashLiteral struct {
-	Token token.Token // the '{' token
-	Pairs map[Expression]Expression
+	Token token.Token       // the '{' token
+	Pairs []HashLiteralPair // pairs by order they were written
 }
 
 func (hl *HashLiteral) expressionNode()      {}
@@ -16,8 +22,8 @@ func (hl *HashLiteral) TokenLiteral() string { return hl.Token.Literal }
 func (hl *HashLiteral) String() string {
 	var out bytes.Buffer
 	pairs := make([]string, len(hl.Pairs))
-	for key, value := range hl.Pairs {
-		pairs = append(pairs, key.String()+":"+value.String())
+	for i, pair := range hl.Pairs {
+		pairs[i] = pair.Key.String() + ":" + pair.Value.String()
 	}
 	out.WriteString("{")
 	out.WriteString(strings.Join(pairs, ", "))
@@ -8,6 +8,7 @@ type Identifier struct {
 	Token token.Token // the token.IDENT token
 	Value string
 	Stack Stack
+	Type  *TypeAnnotation // optional type annotation, nil when isn't declared
 }
 
 func (i *Identifier) expressionNode()      {}
@@ -15,3 +16,11 @@ func (i *Identifier) TokenLiteral() string { return i.Token.Literal }
 func (i *Identifier) String() string {
 	return i.Value
 }
+
+// Declaration print identifier with it is type annotation, e.g.: a: int
+func (i *Identifier) Declaration() string {
+	if i.Type == nil {
+		return i.Value
+	}
+	return i.Value + ": " + i.Type.String()
+}
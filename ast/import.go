@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/gravataLonga/ninja/token"
+	"strings"
 )
 
+// Import load a module, it can bind module to an alias: import "lib.nj" as lib;
+// or bind exported names: import {a, b} from "lib.nj";
 type Import struct {
 	Token    token.Token
 	Filename Expression
+	Alias    *Identifier
+	Names    []*Identifier
 }
 
 func (i *Import) expressionNode()      {}
@@ -18,7 +23,20 @@ func (i *Import) String() string {
 
 	out.WriteString(i.TokenLiteral())
 	out.WriteString(" ")
+	if i.Names != nil {
+		names := make([]string, len(i.Names))
+		for j, name := range i.Names {
+			names[j] = name.String()
+		}
+		out.WriteString("{")
+		out.WriteString(strings.Join(names, ", "))
+		out.WriteString("} from ")
+	}
 	out.WriteString(fmt.Sprintf("\"%s\"", i.Filename))
+	if i.Alias != nil {
+		out.WriteString(" as ")
+		out.WriteString(i.Alias.String())
+	}
 
 	return out.String()
 }
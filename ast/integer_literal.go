@@ -1,10 +1,14 @@
 package ast
 
-import "github.com/gravataLonga/ninja/token"
+import (
+	"github.com/gravataLonga/ninja/token"
+	"math/big"
+)
 
 type IntegerLiteral struct {
 	Token token.Token
 	Value int64
+	Big   *big.Int // value of literals which don't fit on int64
 }
 
 func (il *IntegerLiteral) expressionNode()      {}
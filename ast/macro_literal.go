@@ -0,0 +1,32 @@
+package ast
+
+import (
+	"bytes"
+	"github.com/gravataLonga/ninja/token"
+	"strings"
+)
+
+// MacroLiteral is a macro definition, e.g.: var unless = macro(cond, body) { quote(...) };
+// macros are expanded before program is evaluated.
+type MacroLiteral struct {
+	Token      token.Token // The 'macro' token
+	Parameters []*Identifier
+	Body       *BlockStatement
+}
+
+func (ml *MacroLiteral) expressionNode()      {}
+func (ml *MacroLiteral) TokenLiteral() string { return ml.Token.Literal }
+func (ml *MacroLiteral) String() string {
+	var out bytes.Buffer
+	params := make([]string, len(ml.Parameters))
+	for i, p := range ml.Parameters {
+		params[i] = p.String()
+	}
+	out.WriteString(ml.TokenLiteral())
+	out.WriteString("(")
+	out.WriteString(strings.Join(params, ", "))
+	out.WriteString(") {")
+	out.WriteString(ml.Body.String())
+	out.WriteString("}")
+	return out.String()
+}
@@ -0,0 +1,224 @@
+package ast
+
+// ModifierFunc receive a node and return node which will replace it
+type ModifierFunc func(Node) Node
+
+// Modify walk node tree, children first, replacing each node by what modifier
+// return. Original tree is left untouched, composite nodes are copied, so same
+// tree can be modified many times, e.g.: macro body on each expansion.
+func Modify(node Node, modifier ModifierFunc) Node {
+	switch node := node.(type) {
+	case *Program:
+		n := *node
+		n.Statements = modifyStatements(node.Statements, modifier)
+		return modifier(&n)
+	case *BlockStatement:
+		if node == nil {
+			return node
+		}
+		n := *node
+		n.Statements = modifyStatements(node.Statements, modifier)
+		return modifier(&n)
+	case *ExpressionStatement:
+		n := *node
+		n.Expression = modifyExpression(node.Expression, modifier)
+		return modifier(&n)
+	case *VarStatement:
+		if node == nil {
+			return node
+		}
+		n := *node
+		n.Name = modifyIdentifier(node.Name, modifier)
+		n.Value = modifyExpression(node.Value, modifier)
+		return modifier(&n)
+	case *ConstStatement:
+		n := *node
+		n.Name = modifyIdentifier(node.Name, modifier)
+		n.Value = modifyExpression(node.Value, modifier)
+		return modifier(&n)
+	case *AssignStatement:
+		n := *node
+		n.Name = modifyExpression(node.Name, modifier)
+		n.Value = modifyExpression(node.Value, modifier)
+		return modifier(&n)
+	case *ReturnStatement:
+		n := *node
+		n.ReturnValue = modifyExpression(node.ReturnValue, modifier)
+		return modifier(&n)
+	case *DeleteStatement:
+		n := *node
+		n.Left = modifyExpression(node.Left, modifier)
+		n.Index = modifyExpression(node.Index, modifier)
+		return modifier(&n)
+	case *ForStatement:
+		n := *node
+		if node.InitialCondition != nil {
+			n.InitialCondition, _ = Modify(node.InitialCondition, modifier).(*VarStatement)
+		}
+		n.Condition = modifyExpression(node.Condition, modifier)
+		if node.Iteration != nil {
+			n.Iteration, _ = Modify(node.Iteration, modifier).(Statement)
+		}
+		n.Body = modifyBlock(node.Body, modifier)
+		return modifier(&n)
+	case *EnumStatement:
+		n := *node
+		n.Branches = make(map[string]Expression, len(node.Branches))
+		n.Cases = make([]*EnumCase, len(node.Cases))
+		for i, c := range node.Cases {
+			copied := *c
+			copied.Value = modifyExpression(c.Value, modifier)
+			if copied.Value != nil {
+				n.Branches[c.Name] = copied.Value
+			}
+			n.Cases[i] = &copied
+		}
+		n.Methods = make([]*FunctionLiteral, len(node.Methods))
+		for i, m := range node.Methods {
+			n.Methods[i], _ = Modify(m, modifier).(*FunctionLiteral)
+		}
+		return modifier(&n)
+	case *InfixExpression:
+		n := *node
+		n.Left = modifyExpression(node.Left, modifier)
+		n.Right = modifyExpression(node.Right, modifier)
+		return modifier(&n)
+	case *PrefixExpression:
+		n := *node
+		n.Right = modifyExpression(node.Right, modifier)
+		return modifier(&n)
+	case *PostfixExpression:
+		n := *node
+		n.Left = modifyExpression(node.Left, modifier)
+		return modifier(&n)
+	case *IndexExpression:
+		n := *node
+		n.Left = modifyExpression(node.Left, modifier)
+		n.Index = modifyExpression(node.Index, modifier)
+		return modifier(&n)
+	case *Dot:
+		n := *node
+		n.Object = modifyExpression(node.Object, modifier)
+		n.Right = modifyExpression(node.Right, modifier)
+		return modifier(&n)
+	case *ScopeOperatorExpression:
+		n := *node
+		n.AccessIdentifier = modifyExpression(node.AccessIdentifier, modifier)
+		n.PropertyIdentifier = modifyExpression(node.PropertyIdentifier, modifier)
+		return modifier(&n)
+	case *IfExpression:
+		n := *node
+		n.Condition = modifyExpression(node.Condition, modifier)
+		n.Consequence = modifyBlock(node.Consequence, modifier)
+		n.Alternative = modifyBlock(node.Alternative, modifier)
+		return modifier(&n)
+	case *TernaryOperatorExpression:
+		n := *node
+		n.Condition = modifyExpression(node.Condition, modifier)
+		n.Consequence = modifyExpression(node.Consequence, modifier)
+		n.Alternative = modifyExpression(node.Alternative, modifier)
+		return modifier(&n)
+	case *ElvisOperatorExpression:
+		n := *node
+		n.Left = modifyExpression(node.Left, modifier)
+		n.Right = modifyExpression(node.Right, modifier)
+		return modifier(&n)
+	case *CallExpression:
+		n := *node
+		n.Function = modifyExpression(node.Function, modifier)
+		n.Arguments = modifyExpressions(node.Arguments, modifier)
+		return modifier(&n)
+	case *FunctionLiteral:
+		n := *node
+		if node.Name != nil {
+			n.Name = modifyIdentifier(node.Name, modifier)
+		}
+		n.Parameters = modifyExpressions(node.Parameters, modifier)
+		n.Body = modifyBlock(node.Body, modifier)
+		return modifier(&n)
+	case *MacroLiteral:
+		n := *node
+		n.Parameters = make([]*Identifier, len(node.Parameters))
+		for i, p := range node.Parameters {
+			n.Parameters[i] = modifyIdentifier(p, modifier)
+		}
+		n.Body = modifyBlock(node.Body, modifier)
+		return modifier(&n)
+	case *ArrayLiteral:
+		n := *node
+		n.Elements = modifyExpressions(node.Elements, modifier)
+		return modifier(&n)
+	case *SetLiteral:
+		n := *node
+		n.Elements = modifyExpressions(node.Elements, modifier)
+		return modifier(&n)
+	case *HashLiteral:
+		n := *node
+		n.Pairs = make([]HashLiteralPair, len(node.Pairs))
+		for i, pair := range node.Pairs {
+			n.Pairs[i] = HashLiteralPair{
+				Key:   modifyExpression(pair.Key, modifier),
+				Value: modifyExpression(pair.Value, modifier),
+			}
+		}
+		return modifier(&n)
+	case *ExportStatement:
+		n := *node
+		n.Statement, _ = Modify(node.Statement, modifier).(Statement)
+		return modifier(&n)
+	case *Import:
+		n := *node
+		n.Filename = modifyExpression(node.Filename, modifier)
+		return modifier(&n)
+	case nil:
+		return nil
+	}
+
+	return modifier(node)
+}
+
+func modifyStatements(stmts []Statement, modifier ModifierFunc) []Statement {
+	modified := make([]Statement, 0, len(stmts))
+	for _, stmt := range stmts {
+		if s, ok := Modify(stmt, modifier).(Statement); ok {
+			modified = append(modified, s)
+		}
+	}
+	return modified
+}
+
+func modifyExpressions(exps []Expression, modifier ModifierFunc) []Expression {
+	if exps == nil {
+		return nil
+	}
+
+	modified := make([]Expression, len(exps))
+	for i, exp := range exps {
+		modified[i] = modifyExpression(exp, modifier)
+	}
+	return modified
+}
+
+func modifyExpression(exp Expression, modifier ModifierFunc) Expression {
+	if exp == nil {
+		return nil
+	}
+	modified, _ := Modify(exp, modifier).(Expression)
+	return modified
+}
+
+func modifyIdentifier(ident *Identifier, modifier ModifierFunc) *Identifier {
+	modified, ok := Modify(ident, modifier).(*Identifier)
+	if !ok {
+		return ident
+	}
+	return modified
+}
+
+func modifyBlock(block *BlockStatement, modifier ModifierFunc) *BlockStatement {
+	if block == nil {
+		return nil
+	}
+	modified, _ := Modify(block, modifier).(*BlockStatement)
+	return modified
+}
@@ -0,0 +1,206 @@
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModify(t *testing.T) {
+	one := func() Expression { return &IntegerLiteral{Value: 1} }
+	two := func() Expression { return &IntegerLiteral{Value: 2} }
+
+	turnOneIntoTwo := func(node Node) Node {
+		integer, ok := node.(*IntegerLiteral)
+		if !ok {
+			return node
+		}
+
+		if integer.Value != 1 {
+			return node
+		}
+
+		integer = &IntegerLiteral{Value: 2}
+		return integer
+	}
+
+	tests := []struct {
+		input    Node
+		expected Node
+	}{
+		{
+			one(),
+			two(),
+		},
+		{
+			&Program{
+				Statements: []Statement{
+					&ExpressionStatement{Expression: one()},
+				},
+			},
+			&Program{
+				Statements: []Statement{
+					&ExpressionStatement{Expression: two()},
+				},
+			},
+		},
+		{
+			&InfixExpression{Left: one(), Operator: "+", Right: two()},
+			&InfixExpression{Left: two(), Operator: "+", Right: two()},
+		},
+		{
+			&InfixExpression{Left: two(), Operator: "+", Right: one()},
+			&InfixExpression{Left: two(), Operator: "+", Right: two()},
+		},
+		{
+			&PrefixExpression{Operator: "-", Right: one()},
+			&PrefixExpression{Operator: "-", Right: two()},
+		},
+		{
+			&IndexExpression{Left: one(), Index: one()},
+			&IndexExpression{Left: two(), Index: two()},
+		},
+		{
+			&IfExpression{
+				Condition: one(),
+				Consequence: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: one()},
+					},
+				},
+				Alternative: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: one()},
+					},
+				},
+			},
+			&IfExpression{
+				Condition: two(),
+				Consequence: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: two()},
+					},
+				},
+				Alternative: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: two()},
+					},
+				},
+			},
+		},
+		{
+			&ReturnStatement{ReturnValue: one()},
+			&ReturnStatement{ReturnValue: two()},
+		},
+		{
+			&VarStatement{Name: &Identifier{Value: "a"}, Value: one()},
+			&VarStatement{Name: &Identifier{Value: "a"}, Value: two()},
+		},
+		{
+			&FunctionLiteral{
+				Parameters: []Expression{},
+				Body: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: one()},
+					},
+				},
+			},
+			&FunctionLiteral{
+				Parameters: []Expression{},
+				Body: &BlockStatement{
+					Statements: []Statement{
+						&ExpressionStatement{Expression: two()},
+					},
+				},
+			},
+		},
+		{
+			&ArrayLiteral{Elements: []Expression{one(), one()}},
+			&ArrayLiteral{Elements: []Expression{two(), two()}},
+		},
+		{
+			&CallExpression{Function: &Identifier{Value: "f"}, Arguments: []Expression{one(), two()}},
+			&CallExpression{Function: &Identifier{Value: "f"}, Arguments: []Expression{two(), two()}},
+		},
+		{
+			&TernaryOperatorExpression{Condition: one(), Consequence: one(), Alternative: two()},
+			&TernaryOperatorExpression{Condition: two(), Consequence: two(), Alternative: two()},
+		},
+	}
+
+	for _, tt := range tests {
+		modified := Modify(tt.input, turnOneIntoTwo)
+
+		equal := reflect.DeepEqual(modified, tt.expected)
+		if !equal {
+			t.Errorf("not equal. got=%#v, want=%#v", modified, tt.expected)
+		}
+	}
+
+	hashLiteral := &HashLiteral{
+		Pairs: []HashLiteralPair{
+			{Key: one(), Value: one()},
+			{Key: one(), Value: one()},
+		},
+	}
+
+	modified, _ := Modify(hashLiteral, turnOneIntoTwo).(*HashLiteral)
+
+	for _, pair := range modified.Pairs {
+		key, _ := pair.Key.(*IntegerLiteral)
+		if key.Value != 2 {
+			t.Errorf("value is not %d, got=%d", 2, key.Value)
+		}
+		val, _ := pair.Value.(*IntegerLiteral)
+		if val.Value != 2 {
+			t.Errorf("value is not %d, got=%d", 2, val.Value)
+		}
+	}
+}
+
+func TestModifyLeaveOriginalUntouched(t *testing.T) {
+	original := &InfixExpression{Left: &IntegerLiteral{Value: 1}, Operator: "+", Right: &IntegerLiteral{Value: 1}}
+
+	Modify(original, func(node Node) Node {
+		if _, ok := node.(*IntegerLiteral); ok {
+			return &IntegerLiteral{Value: 2}
+		}
+		return node
+	})
+
+	if original.Left.(*IntegerLiteral).Value != 1 || original.Right.(*IntegerLiteral).Value != 1 {
+		t.Errorf("original node was modified. Got: %s", original.String())
+	}
+}
+
+func TestWalk(t *testing.T) {
+	program := &Program{
+		Statements: []Statement{
+			&VarStatement{Name: &Identifier{Value: "a"}, Value: &IntegerLiteral{Value: 1}},
+			&ExpressionStatement{Expression: &CallExpression{
+				Function:  &Identifier{Value: "skip"},
+				Arguments: []Expression{&Identifier{Value: "b"}},
+			}},
+			&ExpressionStatement{Expression: &IfExpression{
+				Condition:   &Identifier{Value: "c"},
+				Consequence: &BlockStatement{Statements: []Statement{&ExpressionStatement{Expression: &Identifier{Value: "d"}}}},
+			}},
+		},
+	}
+
+	var visited []string
+	Walk(program, func(node Node) bool {
+		if call, ok := node.(*CallExpression); ok && call.Function.String() == "skip" {
+			return false
+		}
+
+		if ident, ok := node.(*Identifier); ok {
+			visited = append(visited, ident.Value)
+		}
+		return true
+	})
+
+	expected := []string{"a", "c", "d"}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("visited identifiers expected %v. Got: %v", expected, visited)
+	}
+}
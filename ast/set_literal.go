@@ -0,0 +1,27 @@
+package ast
+
+import (
+	"bytes"
+	"github.com/gravataLonga/ninja/token"
+	"strings"
+)
+
+// SetLiteral is a set of unique elements, e.g.: {1, 2, 3}
+type SetLiteral struct {
+	Token    token.Token // the '{' token
+	Elements []Expression
+}
+
+func (sl *SetLiteral) expressionNode()      {}
+func (sl *SetLiteral) TokenLiteral() string { return sl.Token.Literal }
+func (sl *SetLiteral) String() string {
+	var out bytes.Buffer
+	elements := make([]string, len(sl.Elements))
+	for i, el := range sl.Elements {
+		elements[i] = el.String()
+	}
+	out.WriteString("{")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("}")
+	return out.String()
+}
@@ -0,0 +1,15 @@
+package ast
+
+import "github.com/gravataLonga/ninja/token"
+
+// TypeAnnotation is an optional type declared on variables, constants,
+// parameters and function return, e.g.: var a: int = 1;
+type TypeAnnotation struct {
+	Token token.Token // the type name token
+	Name  string
+}
+
+func (ta *TypeAnnotation) TokenLiteral() string { return ta.Token.Literal }
+func (ta *TypeAnnotation) String() string {
+	return ta.Name
+}
@@ -16,7 +16,7 @@ func (ls *VarStatement) TokenLiteral() string { return ls.Token.Literal }
 func (ls *VarStatement) String() string {
 	var out bytes.Buffer
 	out.WriteString(ls.TokenLiteral() + " ")
-	out.WriteString(ls.Name.String())
+	out.WriteString(ls.Name.Declaration())
 	out.WriteString(" = ")
 
 	if ls.Value != nil {
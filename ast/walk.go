@@ -0,0 +1,121 @@
+package ast
+
+// Walk traverse node tree in depth first order, calling fn for each node
+// before it is children, when fn return false children of node are skipped.
+func Walk(node Node, fn func(Node) bool) {
+	if isNilNode(node) || !fn(node) {
+		return
+	}
+
+	for _, child := range children(node) {
+		Walk(child, fn)
+	}
+}
+
+// isNilNode check for nil interface and nil pointers of optional nodes
+func isNilNode(node Node) bool {
+	switch node := node.(type) {
+	case nil:
+		return true
+	case *BlockStatement:
+		return node == nil
+	case *VarStatement:
+		return node == nil
+	case *Identifier:
+		return node == nil
+	case *FunctionLiteral:
+		return node == nil
+	}
+	return false
+}
+
+func children(node Node) []Node {
+	switch node := node.(type) {
+	case *Program:
+		nodes := make([]Node, len(node.Statements))
+		for i, stmt := range node.Statements {
+			nodes[i] = stmt
+		}
+		return nodes
+	case *BlockStatement:
+		nodes := make([]Node, len(node.Statements))
+		for i, stmt := range node.Statements {
+			nodes[i] = stmt
+		}
+		return nodes
+	case *ExpressionStatement:
+		return []Node{node.Expression}
+	case *VarStatement:
+		return []Node{node.Name, node.Value}
+	case *ConstStatement:
+		return []Node{node.Name, node.Value}
+	case *AssignStatement:
+		return []Node{node.Name, node.Value}
+	case *ReturnStatement:
+		return []Node{node.ReturnValue}
+	case *DeleteStatement:
+		return []Node{node.Left, node.Index}
+	case *ForStatement:
+		return []Node{node.InitialCondition, node.Condition, node.Iteration, node.Body}
+	case *EnumStatement:
+		nodes := []Node{node.Identifier}
+		for _, c := range node.Cases {
+			nodes = append(nodes, c.Value)
+		}
+		for _, m := range node.Methods {
+			nodes = append(nodes, m)
+		}
+		return nodes
+	case *InfixExpression:
+		return []Node{node.Left, node.Right}
+	case *PrefixExpression:
+		return []Node{node.Right}
+	case *PostfixExpression:
+		return []Node{node.Left}
+	case *IndexExpression:
+		return []Node{node.Left, node.Index}
+	case *Dot:
+		return []Node{node.Object, node.Right}
+	case *ScopeOperatorExpression:
+		return []Node{node.AccessIdentifier, node.PropertyIdentifier}
+	case *IfExpression:
+		return []Node{node.Condition, node.Consequence, node.Alternative}
+	case *TernaryOperatorExpression:
+		return []Node{node.Condition, node.Consequence, node.Alternative}
+	case *ElvisOperatorExpression:
+		return []Node{node.Left, node.Right}
+	case *CallExpression:
+		return append([]Node{node.Function}, expressionNodes(node.Arguments)...)
+	case *FunctionLiteral:
+		return append(append([]Node{node.Name}, expressionNodes(node.Parameters)...), node.Body)
+	case *MacroLiteral:
+		nodes := make([]Node, 0, len(node.Parameters)+1)
+		for _, p := range node.Parameters {
+			nodes = append(nodes, p)
+		}
+		return append(nodes, node.Body)
+	case *ArrayLiteral:
+		return expressionNodes(node.Elements)
+	case *SetLiteral:
+		return expressionNodes(node.Elements)
+	case *HashLiteral:
+		nodes := make([]Node, 0, len(node.Pairs)*2)
+		for _, pair := range node.Pairs {
+			nodes = append(nodes, pair.Key, pair.Value)
+		}
+		return nodes
+	case *ExportStatement:
+		return []Node{node.Statement}
+	case *Import:
+		return []Node{node.Filename}
+	}
+	return nil
+}
+
+func expressionNodes(exps []Expression) []Node {
+	nodes := make([]Node, len(exps))
+	for i, exp := range exps {
+		nodes[i] = exp
+	}
+	return nodes
+}
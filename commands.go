@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gravataLonga/ninja/packages"
+	"io"
+	"sort"
+)
+
+// runCommand run package manager commands, it tells if args were a command.
+//
+//	ninja init [name]
+//	ninja install [name source]
+//	ninja update [name ...]
+func runCommand(args []string, dir string, writer io.Writer) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	switch args[0] {
+	case "init":
+		return true, initProject(args[1:], dir, writer)
+	case "install":
+		return true, installPackages(args[1:], dir, writer)
+	case "update":
+		return true, updatePackages(args[1:], dir, writer)
+	}
+	return false, nil
+}
+
+func initProject(args []string, dir string, writer io.Writer) error {
+	if len(args) > 1 {
+		return errors.New("usage: ninja init [name]")
+	}
+
+	name := ""
+	if len(args) == 1 {
+		name = args[0]
+	}
+
+	manifest, err := packages.Init(dir, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "created %s for %s\n", packages.ManifestFile, manifest.Name)
+	return nil
+}
+
+func installPackages(args []string, dir string, writer io.Writer) error {
+	installer, err := projectInstaller(dir)
+	if err != nil {
+		return err
+	}
+
+	var lock *packages.Lock
+	switch len(args) {
+	case 0:
+		lock, err = installer.Install()
+	case 2:
+		lock, err = installer.Add(args[0], args[1])
+	default:
+		return errors.New("usage: ninja install [name source]")
+	}
+
+	if err != nil {
+		return err
+	}
+	printLock(lock, writer)
+	return nil
+}
+
+// updatePackages install given dependencies, or all of them, locking their
+// current content
+func updatePackages(args []string, dir string, writer io.Writer) error {
+	installer, err := projectInstaller(dir)
+	if err != nil {
+		return err
+	}
+
+	lock, err := installer.Update(args...)
+	if err != nil {
+		return err
+	}
+	printLock(lock, writer)
+	return nil
+}
+
+func projectInstaller(dir string) (*packages.Installer, error) {
+	root, ok := packages.FindRoot(dir)
+	if !ok {
+		return nil, fmt.Errorf("%s not found, run ninja init first", packages.ManifestFile)
+	}
+	return packages.NewInstaller(root), nil
+}
+
+func printLock(lock *packages.Lock, writer io.Writer) {
+	names := make([]string, 0, len(lock.Dependencies))
+	for name := range lock.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		dep := lock.Dependencies[name]
+		fmt.Fprintf(writer, "installed %s from %s (%s)\n", name, dep.Source, dep.Hash)
+	}
+}
@@ -368,6 +368,74 @@ func TestArrayMethodWrongUsage(t *testing.T) {
 			`[1].slice(1, 2, 3)`,
 			`TypeError: array.push() takes at least 1 arguments at most 2 (3 given)`,
 		},
+		{
+			`[1].map(1)`,
+			"TypeError: array.map() expected argument #1 to be `FUNCTION` got `INTEGER`",
+		},
+		{
+			`[1].filter()`,
+			"TypeError: array.filter() takes exactly 1 argument (0 given)",
+		},
+		{
+			`[].reduce(function(acc, v) { return acc + v; })`,
+			"TypeError: array.reduce() of empty array with no initial value",
+		},
+		{
+			`[1, "a"].sort()`,
+			"TypeError: array.sort() unable to compare `STRING` with `INTEGER`",
+		},
+		{
+			`[2, 1].sort(function(a, b) { return true; })`,
+			"TypeError: array.sort() comparator expected to return `INTEGER` got `BOOLEAN`",
+		},
+		{
+			`[[1], {"a": 1}].unique()`,
+			"TypeError: array.unique() unusable element: HASH",
+		},
+		{
+			`[1].zip(1)`,
+			"TypeError: array.zip() expected argument #1 to be `ARRAY` got `INTEGER`",
+		},
+		{
+			`[1].chunk(0)`,
+			"ValueError: array.chunk() size must be greater than 0 got 0",
+		},
+		{
+			`[1].flatten(-1)`,
+			"ValueError: array.flatten() depth must not be negative got -1",
+		},
+		{
+			`[1].groupBy(function(v) { return {}; })`,
+			"TypeError: array.groupBy() unusable as hash key: HASH",
+		},
+		{
+			`[1].insert(2, 1)`,
+			"IndexError: array.insert() index 2 out of range of array with length 1",
+		},
+		{
+			`[1].remove(1)`,
+			"IndexError: array.remove() index 1 out of range of array with length 1",
+		},
+		{
+			`var a = freeze([1]); a.unshift(0)`,
+			"TypeError: array.unshift() cannot modify frozen array",
+		},
+		{
+			`var a = freeze([1]); a.insert(0, 1)`,
+			"TypeError: array.insert() cannot modify frozen array",
+		},
+		{
+			`var a = freeze([1]); a.remove(0)`,
+			"TypeError: array.remove() cannot modify frozen array",
+		},
+		{
+			`[1].map(function(v) { return v.foo(); })`,
+			"method foo not exists on integer object.",
+		},
+		{
+			`[1].map(function(a, b, c) { return a; })`,
+			"Function expected 3 arguments, got 2 at { at [Line: 1, Offset: 27]",
+		},
 	}
 
 	for i, tt := range tests {
@@ -385,3 +453,77 @@ func TestArrayMethodWrongUsage(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayHigherOrderMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`[1, 2, 3].map(function(v) { return v * 2; })`, `[2, 4, 6]`},
+		{`[1, 2, 3].map(function(v, i) { return i; })`, `[0, 1, 2]`},
+		{`["a", "bb"].map(len)`, `[1, 2]`},
+		{`[].map(function(v) { return v; })`, `[]`},
+		{`[1, 2].map(function() { 1 })`, `[1, 1]`},
+		{`[1, 2, 3].filter(function() { return true; })`, `[1, 2, 3]`},
+		{`[1, 2, 3].reduce(function(acc) { return acc + 1; }, 0)`, `3`},
+		{`[1, 2, 3, 4].filter(function(v) { return v % 2 == 0; })`, `[2, 4]`},
+		{`[1, 2, 3].filter(function(v, i) { return i > 0; })`, `[2, 3]`},
+		{`[1, 2, 3].reduce(function(acc, v) { return acc + v; })`, `6`},
+		{`[1, 2, 3].reduce(function(acc, v) { return acc + v; }, 10)`, `16`},
+		{`[1, 2, 3].reduce(function(acc, v, i) { return acc + i; }, 0)`, `3`},
+		{`[].reduce(function(acc, v) { return acc + v; }, 0)`, `0`},
+		{`[1, 2, 3].find(function(v) { return v > 1; })`, `2`},
+		{`[1, 2, 3].find(function(v) { return v > 3; })`, `null`},
+		{`[1, 2, 3].findIndex(function(v) { return v > 1; })`, `1`},
+		{`[1, 2, 3].findIndex(function(v) { return v > 3; })`, `-1`},
+		{`[1, 2, 3].some(function(v) { return v > 2; })`, `true`},
+		{`[].some(function(v) { return true; })`, `false`},
+		{`[1, 2, 3].every(function(v) { return v > 0; })`, `true`},
+		{`[1, 2, 3].every(function(v) { return v > 1; })`, `false`},
+		{`[1, 2].flatMap(function(v) { return [v, v * 10]; })`, `[1, 10, 2, 20]`},
+		{`[1, 2].flatMap(function(v) { return v; })`, `[1, 2]`},
+		{`[1, [2, [3, [4]]]].flatten()`, `[1, 2, [3, [4]]]`},
+		{`[1, [2, [3, [4]]]].flatten(2)`, `[1, 2, 3, [4]]`},
+		{`[1, [2, [3, [4]]]].flatten(0)`, `[1, [2, [3, [4]]]]`},
+		{`[3, 1, 2].sort()`, `[1, 2, 3]`},
+		{`[2.5, 1, 2].sort()`, `[1, 2, 2.500000]`},
+		{`["b", "c", "a"].sort()`, `[a, b, c]`},
+		{`[3, 1, 2].sort(function(a, b) { return b - a; })`, `[3, 2, 1]`},
+		{`var a = [3, 1, 2]; a.sort(); a`, `[3, 1, 2]`},
+		{`enum Size { case S; case M; case L; }; [Size::L, Size::S, Size::M].sort()`, `[Size::S, Size::M, Size::L]`},
+		{`[1, 2, 3].reverse()`, `[3, 2, 1]`},
+		{`var a = [1, 2]; a.reverse(); a`, `[1, 2]`},
+		{`[1, 2, 1, "a", "a", [1], [1]].unique()`, `[1, 2, a, [1]]`},
+		{`[1, 2, 3].zip(["a", "b"])`, `[[1, a], [2, b]]`},
+		{`[1, 2].zip(["a", "b"], [true, false])`, `[[1, a, true], [2, b, false]]`},
+		{`[1, 2, 3, 4, 5].chunk(2)`, `[[1, 2], [3, 4], [5]]`},
+		{`[].chunk(2)`, `[]`},
+		{`[1, 2, 3, 4].groupBy(function(v) { return v % 2; })`, `{1: [1, 3], 0: [2, 4]}`},
+		{`["a", "bb", "c"].groupBy(len)`, `{1: [a, c], 2: [bb]}`},
+		{`[1, 2, 3].indexOf(2)`, `1`},
+		{`[1, 2, 3].indexOf(4)`, `-1`},
+		{`["a", [1]].indexOf([1])`, `1`},
+		{`[1, 2, 3].contains(3)`, `true`},
+		{`[1, 2, 3].contains("3")`, `false`},
+		{`var a = [1, 4]; a.insert(1, 2, 3); a`, `[1, 2, 3, 4]`},
+		{`var a = [1]; a.insert(1, 2); a`, `[1, 2]`},
+		{`var a = [1, 2, 3]; a.remove(1)`, `2`},
+		{`var a = [1, 2, 3]; a.remove(1); a`, `[1, 3]`},
+		{`var a = [3]; a.unshift(1, 2); a`, `[1, 2, 3]`},
+		{`var seen = []; [1, 2, 3].map(function(v) { seen.push(v); return v; }); seen`, `[1, 2, 3]`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestArrayHigherOrderMethod[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated == nil {
+				t.Fatalf("expected %s. Got nil", tt.expected)
+			}
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %s. Got: %s", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package evaluator
+
+import (
+	"github.com/gravataLonga/ninja/object"
+	"math"
+	"math/big"
+)
+
+// evalBigIntInfixExpression evaluate integers with arbitrary precision, it is
+// used when one of operands is a BigInt or result of integers overflow int64.
+func evalBigIntInfixExpression(
+	operator string,
+	left, right object.Object,
+) object.Object {
+	leftVal, _ := object.ToBigInt(left)
+	rightVal, _ := object.ToBigInt(right)
+
+	switch operator {
+	case "+":
+		return object.NewInteger(new(big.Int).Add(leftVal, rightVal))
+	case "-":
+		return object.NewInteger(new(big.Int).Sub(leftVal, rightVal))
+	case "*":
+		return object.NewInteger(new(big.Int).Mul(leftVal, rightVal))
+	case "%":
+		if rightVal.Sign() == 0 {
+			return object.NewErrorFormat("division by zero: %s %% %s", leftVal, rightVal)
+		}
+		return object.NewInteger(new(big.Int).Rem(leftVal, rightVal))
+	case "/":
+		if rightVal.Sign() == 0 {
+			return &object.Float{Value: bigToFloat(leftVal) / 0}
+		}
+
+		quotient, remainder := new(big.Int).QuoRem(leftVal, rightVal, new(big.Int))
+		if remainder.Sign() == 0 {
+			return object.NewInteger(quotient)
+		}
+
+		value, _ := new(big.Rat).SetFrac(leftVal, rightVal).Float64()
+		return &object.Float{Value: value}
+	case "**":
+		if rightVal.Sign() < 0 {
+			return &object.Integer{Value: int64(math.Pow(bigToFloat(leftVal), bigToFloat(rightVal)))}
+		}
+
+		if !powerFits(leftVal, rightVal) {
+			return object.NewErrorFormat("exponent too large: %s ** %s", leftVal, rightVal)
+		}
+		return object.NewInteger(new(big.Int).Exp(leftVal, rightVal, nil))
+	case "&":
+		return object.NewInteger(new(big.Int).And(leftVal, rightVal))
+	case "|":
+		return object.NewInteger(new(big.Int).Or(leftVal, rightVal))
+	case "^":
+		return object.NewInteger(new(big.Int).Xor(leftVal, rightVal))
+	case "<<", ">>":
+		if rightVal.Sign() < 0 {
+			return object.NewErrorFormat("negative shift count: %s %s %s", leftVal, operator, rightVal)
+		}
+
+		if !rightVal.IsUint64() || rightVal.Uint64() > math.MaxUint32 {
+			return object.NewErrorFormat("shift count too large: %s %s %s", leftVal, operator, rightVal)
+		}
+
+		if operator == "<<" {
+			return object.NewInteger(new(big.Int).Lsh(leftVal, uint(rightVal.Uint64())))
+		}
+		return object.NewInteger(new(big.Int).Rsh(leftVal, uint(rightVal.Uint64())))
+	case "<":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) == -1)
+	case ">":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) == 1)
+	case "==":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) == 0)
+	case "!=":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) != 0)
+	case "<=":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) <= 0)
+	case ">=":
+		return nativeBoolToBooleanObject(leftVal.Cmp(rightVal) >= 0)
+	default:
+		return object.NewErrorFormat("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
+
+// MaxPowerBits limit size of a power result, unlike shifts powers need many
+// multiplications which get slow long before running out of memory
+const MaxPowerBits = 1 << 24
+
+// powerFits tell if base ** exponent has at most MaxPowerBits bits, result
+// has at least (bits of base - 1) * exponent bits
+func powerFits(base, exponent *big.Int) bool {
+	bits := new(big.Int).Mul(big.NewInt(int64(base.BitLen()-1)), exponent)
+	return bits.Cmp(big.NewInt(MaxPowerBits)) <= 0
+}
+
+func bigToFloat(value *big.Int) float64 {
+	return (&object.BigInt{Value: value}).Float()
+}
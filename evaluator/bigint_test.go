@@ -0,0 +1,144 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestIntegerLiteralBasesAndSeparators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"0b1010", 10},
+		{"0o755", 493},
+		{"0xFF", 255},
+		{"1_000_000", 1000000},
+		{"1_000.5", 1000.5},
+		{"0b1010 + 0o10 + 1_000", 1018},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestIntegerLiteralBasesAndSeparators[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestIntegerOverflowPromoteToBigInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9223372036854775807 + 1", "9223372036854775808"},
+		{"-9223372036854775807 - 2", "-9223372036854775809"},
+		{"9223372036854775807 * 2", "18446744073709551614"},
+		{"2 ** 64", "18446744073709551616"},
+		{"1 << 64", "18446744073709551616"},
+		{"-(-9223372036854775807 - 1)", "9223372036854775808"},
+		{"var a = 9223372036854775807; a++; a;", "9223372036854775808"},
+		{"var a = -9223372036854775807 - 1; --a;", "-9223372036854775809"},
+		{"(-9223372036854775807 - 1).abs()", "9223372036854775808"},
+		{"100000000000000000000", "100000000000000000000"},
+		{"100000000000000000000 * 3 + 1", "300000000000000000001"},
+		{"0xFFFFFFFFFFFFFFFFFF", "4722366482869645213695"},
+		{"-(2 ** 64)", "-18446744073709551616"},
+		{"(2 ** 64) & (2 ** 64 + 1)", "18446744073709551616"},
+		{"(2 ** 64) | 1", "18446744073709551617"},
+		{"(2 ** 66) >> 1", "36893488147419103232"},
+		{"(-9223372036854775807 - 1) / -1", "9223372036854775808"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestIntegerOverflowPromoteToBigInt[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			bigInt, ok := evaluated.(*object.BigInt)
+			if !ok {
+				t.Fatalf("object is not BigInt. got=%T (%+v)", evaluated, evaluated)
+			}
+
+			if bigInt.Value.String() != tt.expected {
+				t.Errorf("object has wrong value. got=%s, want=%s", bigInt.Value, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBigIntDemoteToInteger(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"9223372036854775807 + 1 - 1", 9223372036854775807},
+		{"(2 ** 64) / (2 ** 32)", 4294967296},
+		{"(2 ** 64) - (2 ** 64)", 0},
+		{"1 ** (2 ** 64)", 1},
+		{"0 ** (2 ** 64)", 0},
+		{"(-1) ** (2 ** 64 + 1)", -1},
+		{"(2 ** 1000) ** 16384 == 1 << 16384000", true},
+		{"(2 ** 100) % 7", 2},
+		{"(2 ** 64) / 3", 6148914691236517205.0 + 1.0/3},
+		{"27021597764222979 / 3", 9007199254740993},
+		{"9007199254740993 / 1", 9007199254740993},
+		{"-9223372036854775807 / -1", 9223372036854775807},
+		{"-5 / 2", -2.5},
+		{"7 / 2", 3.5},
+		{"(2 ** 64) + 0.5", 18446744073709551616.5},
+		{"(2 ** 64) > 1", true},
+		{"1 < 2 ** 64", true},
+		{"2 ** 64 == 2 ** 64", true},
+		{"2 ** 64 == 2 ** 65", false},
+		{"2 ** 64 != 1", true},
+		{"2 ** 64 >= 2 ** 64", true},
+		{"(2 ** 64).type()", "BIGINT"},
+		{"(2 ** 64).string()", "18446744073709551616"},
+		{"(2 ** 64).float()", 18446744073709551616.0},
+		{"(-(2 ** 64)).abs() == 2 ** 64", true},
+		{"{2 ** 64: 1}[2 ** 64]", 1},
+		{"function (a: int): int { return a; }(2 ** 64) == 2 ** 64", true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBigIntDemoteToInteger[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestBigIntErrors(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{"1 % 0", "division by zero: 1 % 0"},
+		{"(2 ** 64) % 0", "division by zero: 18446744073709551616 % 0"},
+		{"1 << -1", "negative shift count: 1 << -1"},
+		{"(2 ** 64) >> -1", "negative shift count: 18446744073709551616 >> -1"},
+		{"1 << (2 ** 64)", "shift count too large: 1 << 18446744073709551616"},
+		{"(2 ** 64) ** (2 ** 64)", "exponent too large: 18446744073709551616 ** 18446744073709551616"},
+		{"2 ** 9999999999", "exponent too large: 2 ** 9999999999"},
+		{"-10 ** 6000000", "exponent too large: -10 ** 6000000"},
+		{"(2 ** 64) ** 262145", "exponent too large: 18446744073709551616 ** 262145"},
+		{"(2 ** 64).ups()", "method ups not exists on bigint object."},
+		{"(2 ** 64).string(1)", "TypeError: bigint.string() takes exactly 0 argument (1 given)"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBigIntErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
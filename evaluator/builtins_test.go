@@ -1,8 +1,10 @@
 package evaluator
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/gravataLonga/ninja/object"
+	"strings"
 	"testing"
 )
 
@@ -15,7 +17,7 @@ func TestBuiltinFunctions(t *testing.T) {
 		{`len("")`, 0, false},
 		{`len("four")`, 4, false},
 		{`len("hello world")`, 11, false},
-		{`len(1)`, "TypeError: len() expected argument to be `ARRAY,STRING` got `INTEGER`", false},
+		{`len(1)`, "TypeError: len() expected argument to be `ARRAY,STRING,SET` got `INTEGER`", false},
 		{`len("one", "two")`, "TypeError: len() takes exactly 1 argument (2 given)", false},
 		{`len([1, 2, 3])`, 3, false},
 		{`len([])`, 0, false},
@@ -83,6 +85,189 @@ func TestBuiltinFunctions(t *testing.T) {
 	}
 }
 
+func TestBuiltinSprintf(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`sprintf("hello")`, "hello"},
+		{`sprintf("%d-%5d-%-5d|%05d", 1, 2, 3, 4)`, "1-    2-3    |00004"},
+		{`sprintf("%.3d|%.d", 7, 8)`, "007|8"},
+		{`sprintf("%+d %x %X %o %b", 5, 255, 255, 8, 5)`, "+5 ff FF 10 101"},
+		{`sprintf("%d", 2 ** 70)`, "1180591620717411303424"},
+		{`sprintf("%.2f %8.3f %-8.1f|", 3.14159, 2, 1.25)`, "3.14    2.000 1.2     |"},
+		{`sprintf("%.2f", 1.125d)`, "1.12"},
+		{`sprintf("%e %g", 1500.0, 0.5)`, "1.500000e+03 0.5"},
+		{`sprintf("%s|%5s|%-5s|%.2s", "ninja", "a", "b", "abc")`, "ninja|    a|b    |ab"},
+		{`sprintf("%s %s", 1, [1, "a"])`, "1 [1, a]"},
+		{`sprintf("%q", "a\"b")`, `"a\"b"`},
+		{`sprintf("%v %v %v", {"a": 1}, {1, 2}, 1.5d)`, "{a: 1} {1, 2} 1.5"},
+		{`sprintf("%t %c %T %T", true, 110, "a", 1.5d)`, "true n STRING DECIMAL"},
+		{`sprintf("100%%")`, "100%"},
+		{`sprintf("%j", {"a": [1, 2.0, "x\"<"], 1: {true}, "n": first([])})`, `{"a":[1,2.0,"x\"<"],"1":[true],"n":null}`},
+		{`sprintf("%j", 1.50d)`, "1.50"},
+		{`var none = function() {}; sprintf("%v|%s|%q|%T", none(), none(), none(), none())`, `null|null|"null"|NULL`},
+		{`sprintf("%v %s %T", first([]), first([]), first([]))`, "null null NULL"},
+		{`var none = function() {}; sprintf("%d", none())`, "TypeError: sprintf() verb %d expected argument #2 to be `INTEGER` got `NULL`"},
+		{`sprintf()`, "TypeError: sprintf() takes a minimum 1 arguments (0 given)"},
+		{`sprintf(1)`, "TypeError: sprintf() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`sprintf("%d", "a")`, "TypeError: sprintf() verb %d expected argument #2 to be `INTEGER` got `STRING`"},
+		{`sprintf("%s %f", "a", "b")`, "TypeError: sprintf() verb %f expected argument #3 to be `number` got `STRING`"},
+		{`sprintf("%t", 1)`, "TypeError: sprintf() verb %t expected argument #2 to be `BOOLEAN` got `INTEGER`"},
+		{`sprintf("%d %d", 1)`, "TypeError: sprintf() missing argument for verb %d"},
+		{`sprintf("%d", 1, 2)`, "TypeError: sprintf() format use 1 arguments but 2 were given"},
+		{`sprintf("%y", 1)`, "ValueError: sprintf() unknown verb %y"},
+		{`sprintf("%5", 1)`, "ValueError: sprintf() format end with incomplete verb %5"},
+		{`sprintf("%999999999999d", 1)`, "ValueError: sprintf() width 999999999999 is larger than 1024"},
+		{`sprintf("%99999999999999999999d", 1)`, "ValueError: sprintf() width 99999999999999999999 is larger than 1024"},
+		{`sprintf("%.1000000000f", 1.5)`, "ValueError: sprintf() precision 1000000000 is larger than 1024"},
+		{`sprintf("%1.2.3d", 1)`, "ValueError: sprintf() malformed verb %1.2."},
+		{`sprintf("%..2f", 1.5)`, "ValueError: sprintf() malformed verb %.."},
+		{`sprintf("%j", function() {})`, "TypeError: sprintf() unable to encode `FUNCTION` to json"},
+		{`sprintf("%j", {[1]: 1})`, "TypeError: sprintf() unable to encode `ARRAY` as json key"},
+		{`var a = [1]; a.push(a); sprintf("%j", a)`, "ValueError: sprintf() unable to encode circular reference to json"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBuiltinSprintf[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if errObj, ok := evaluated.(*object.Error); ok {
+				if errObj.Message != tt.expected {
+					t.Errorf("wrong error message. expected=%q, got=%q", tt.expected, errObj.Message)
+				}
+				return
+			}
+
+			testStringObject(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestBuiltinPrintf(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`printf("%s=%d\n", "a", 1)`, "a=1\n"},
+		{`printf("no new line")`, "no new line"},
+		{`print("a", 1, [2])`, "a1[2]"},
+		{`print()`, ""},
+		{`print("a"); print("b")`, "ab"},
+	}
+
+	original := object.StandardOutput
+	defer func() { object.StandardOutput = original }()
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBuiltinPrintf[%d]", i), func(t *testing.T) {
+			out := &bytes.Buffer{}
+			object.StandardOutput = out
+
+			evaluated := testEval(tt.input, t)
+			if evaluated != nil {
+				t.Fatalf("expected nil. Got: %s", evaluated.Inspect())
+			}
+
+			if out.String() != tt.expected {
+				t.Errorf("wrong output. expected=%q, got=%q", tt.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestBuiltinWriters(t *testing.T) {
+	tests := []struct {
+		input  string
+		stdout string
+		stderr string
+	}{
+		{`puts("a", 1)`, "a\n1\n", ""},
+		{`eputs("a", 1)`, "", "a\n1\n"},
+		{`eprint("a", 1)`, "", "a1"},
+		{`puts("out"); eprint("err"); print("!")`, "out\n!", "err"},
+	}
+
+	originalOut, originalErr := object.StandardOutput, object.StandardError
+	defer func() {
+		object.StandardOutput, object.StandardError = originalOut, originalErr
+	}()
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBuiltinWriters[%d]", i), func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			object.StandardOutput, object.StandardError = stdout, stderr
+
+			testEval(tt.input, t)
+
+			if stdout.String() != tt.stdout {
+				t.Errorf("wrong standard output. expected=%q, got=%q", tt.stdout, stdout.String())
+			}
+
+			if stderr.String() != tt.stderr {
+				t.Errorf("wrong standard error. expected=%q, got=%q", tt.stderr, stderr.String())
+			}
+		})
+	}
+}
+
+func TestBuiltinStandardInput(t *testing.T) {
+	tests := []struct {
+		stdin    string
+		input    string
+		expected string
+		stdout   string
+	}{
+		{"hello\n", `readLine()`, "hello", ""},
+		{"hello\r\nworld", `[readLine(), readLine(), readLine()]`, "[hello, world, null]", ""},
+		{"", `readLine()`, "null", ""},
+		{"ninja\n", `input("name: ")`, "ninja", "name: "},
+		{"ninja", `input()`, "ninja", ""},
+		{"", `input("name: ")`, "null", "name: "},
+		{"a\nb\n", `readAll()`, "a\nb\n", ""},
+		{"a\nb\n", `[readLine(), readAll(), readAll()]`, "[a, b\n, null]", ""},
+		{"a\n\nb", `lines().toArray()`, "[a, , b]", ""},
+		{"a\nb", `var it = lines(); [it.next(), readLine(), it.next()]`, "[a, b, null]", ""},
+		{"a\nb", `var it = lines(); it.toArray(); it.next()`, "null", ""},
+		{"1\n2\n3\n", `var total = 0; var it = lines(); for (var l = it.next(); l; l = it.next()) { total = total + l.int(); } total`, "6", ""},
+		{"", `lines().type()`, "ITERATOR", ""},
+		{"", `readLine(1)`, "TypeError: readLine() takes exactly 0 argument (1 given)", ""},
+		{"", `input(1)`, "TypeError: input() expected argument #1 to be `STRING` got `INTEGER`", ""},
+		{"", `readAll(1)`, "TypeError: readAll() takes exactly 0 argument (1 given)", ""},
+		{"", `lines(1)`, "TypeError: lines() takes exactly 0 argument (1 given)", ""},
+		{"", `lines().next(1)`, "TypeError: iterator.next() takes exactly 0 argument (1 given)", ""},
+		{"", `lines().ups()`, "method ups not exists on iterator object.", ""},
+	}
+
+	originalIn, originalOut := object.StandardInput, object.StandardOutput
+	defer func() {
+		object.StandardInput, object.StandardOutput = originalIn, originalOut
+	}()
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestBuiltinStandardInput[%d]", i), func(t *testing.T) {
+			stdout := &bytes.Buffer{}
+			object.StandardInput = strings.NewReader(tt.stdin)
+			object.StandardOutput = stdout
+
+			evaluated := testEval(tt.input, t)
+
+			result := evaluated.Inspect()
+			if errObj, ok := evaluated.(*object.Error); ok {
+				result = errObj.Message
+			}
+
+			if result != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, result)
+			}
+
+			if stdout.String() != tt.stdout {
+				t.Errorf("wrong standard output. expected=%q, got=%q", tt.stdout, stdout.String())
+			}
+		})
+	}
+}
+
 func TestBuiltinTime(t *testing.T) {
 	evaluated := testEval(`time()`, t)
 
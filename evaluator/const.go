@@ -0,0 +1,20 @@
+package evaluator
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/object"
+)
+
+func evalConstStatement(node *ast.ConstStatement, env *object.Environment) object.Object {
+	if env.IsLocalConstant(node.Name.Value) {
+		return object.NewErrorFormat("cannot redeclare constant %s %s", node.Name.Value, node.Name.Token)
+	}
+
+	val := Eval(node.Value, env)
+	if object.IsError(val) {
+		return val
+	}
+
+	env.SetConstant(node.Name.Value, val)
+	return nil
+}
@@ -0,0 +1,188 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestConstStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`const a = 5; a;`, 5},
+		{`const a = 5 * 5; a;`, 25},
+		{`const a = 5; var b = a; b = b + 1; b;`, 6},
+		{`const a = "hello"; function () { var a = 1; a = a + 1; return a; }();`, 2},
+		{`const a = [1]; a[0] = 2; a[0];`, 2},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestConstStatement[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestConstStatementErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{
+			`const a = 5; a = 1;`,
+			"cannot reassign constant a IDENT at [Line: 1, Offset: 15]",
+		},
+		{
+			`const a = 5; var a = 1;`,
+			"cannot redeclare constant a IDENT at [Line: 1, Offset: 19]",
+		},
+		{
+			`const a = 5; const a = 1;`,
+			"cannot redeclare constant a IDENT at [Line: 1, Offset: 21]",
+		},
+		{
+			`const a = 5; a++;`,
+			"cannot reassign constant a IDENT at [Line: 1, Offset: 15]",
+		},
+		{
+			`const a = 5; ++a;`,
+			"cannot reassign constant a IDENT at [Line: 1, Offset: 17]",
+		},
+		{
+			`const a = 5; function () { a = 2; }();`,
+			"cannot reassign constant a IDENT at [Line: 1, Offset: 29]",
+		},
+		{
+			`const a = 5; function a() {};`,
+			"cannot redeclare constant a IDENT at [Line: 1, Offset: 24]",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestConstStatementErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedMessage, errObj.Message)
+			}
+		})
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`freeze(1);`, 1},
+		{`freeze("hello");`, "hello"},
+		{`var a = freeze([1, 2]); a[0];`, 1},
+		{`var a = freeze({"a": 1}); a["a"];`, 1},
+		{`var a = freeze([[1]]); var b = first(a); b[0] = 2; b[0];`, 2},
+		{`enum STATUS { case LIST: [1, 2]; }; var a = STATUS::LIST.value(); a[0];`, 1},
+		{`var a = [1]; a.push(a); freeze(a); a[1][0];`, 1},
+		{`var a = {"a": 1}; a["self"] = a; freeze(a); a["self"]["a"];`, 1},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFreeze[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestFreezeErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{
+			`freeze();`,
+			"TypeError: freeze() takes exactly 1 argument (0 given)",
+		},
+		{
+			`var a = freeze([1]); a[0] = 2;`,
+			"TypeError: cannot modify frozen array",
+		},
+		{
+			`var a = freeze([1]); a[1] = 2;`,
+			"TypeError: cannot modify frozen array",
+		},
+		{
+			`var a = freeze({"a": 1}); a["a"] = 2;`,
+			"TypeError: cannot modify frozen hash",
+		},
+		{
+			`var a = freeze([1]); a.push(2);`,
+			"TypeError: array.push() cannot modify frozen array",
+		},
+		{
+			`var a = [1]; a.push(a); freeze(a); a[1].push(2);`,
+			"TypeError: array.push() cannot modify frozen array",
+		},
+		{
+			`var a = {"a": 1}; a["self"] = a; freeze(a); var b = a["self"]; b["a"] = 2;`,
+			"TypeError: cannot modify frozen hash",
+		},
+		{
+			`var a = freeze([1]); a.pop();`,
+			"TypeError: array.pop() cannot modify frozen array",
+		},
+		{
+			`var a = freeze([1]); a.shift();`,
+			"TypeError: array.shift() cannot modify frozen array",
+		},
+		{
+			`var a = freeze([1]); delete a[0];`,
+			"TypeError: cannot modify frozen array",
+		},
+		{
+			`var a = freeze({"a": 1}); delete a["a"];`,
+			"TypeError: cannot modify frozen hash",
+		},
+		{
+			`var a = freeze({"a": 1}); a.set("b", 2);`,
+			"TypeError: hash.set() cannot modify frozen hash",
+		},
+		{
+			`var a = freeze({"a": 1}); a.remove("a");`,
+			"TypeError: hash.remove() cannot modify frozen hash",
+		},
+		{
+			`var a = freeze({"a": [1]}); var b = a["a"]; b.push(2);`,
+			"TypeError: array.push() cannot modify frozen array",
+		},
+		{
+			`var a = freeze([{"a": 1}]); var b = a[0]; b["a"] = 2;`,
+			"TypeError: cannot modify frozen hash",
+		},
+		{
+			`enum STATUS { case LIST: [1, 2]; }; var a = STATUS::LIST.value(); a.push(3);`,
+			"TypeError: array.push() cannot modify frozen array",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFreezeErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedMessage, errObj.Message)
+			}
+		})
+	}
+}
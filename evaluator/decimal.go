@@ -0,0 +1,104 @@
+package evaluator
+
+import (
+	"github.com/gravataLonga/ninja/object"
+	"math/big"
+)
+
+// evalDecimalInfixExpression evaluate exact decimals, it is used when one of
+// operands is a Decimal, others numbers are converted to Decimal.
+func evalDecimalInfixExpression(
+	operator string,
+	left, right object.Object,
+) object.Object {
+	leftVal, ok := object.ToDecimal(left)
+	if !ok {
+		return object.NewErrorFormat("could not convert %s to decimal: %s %s %s", left.Inspect(), left.Type(), operator, right.Type())
+	}
+
+	rightVal, ok := object.ToDecimal(right)
+	if !ok {
+		return object.NewErrorFormat("could not convert %s to decimal: %s %s %s", right.Inspect(), left.Type(), operator, right.Type())
+	}
+
+	switch operator {
+	case "+":
+		return leftVal.Add(rightVal)
+	case "-":
+		return leftVal.Sub(rightVal)
+	case "*":
+		result, err := leftVal.Mul(rightVal)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return result
+	case "/":
+		if rightVal.Value.Sign() == 0 {
+			return object.NewErrorFormat("division by zero: %s / %s", leftVal, rightVal)
+		}
+		return leftVal.Quo(rightVal, divisionScale(leftVal, rightVal), object.DefaultRoundingMode)
+	case "%":
+		if rightVal.Value.Sign() == 0 {
+			return object.NewErrorFormat("division by zero: %s %% %s", leftVal, rightVal)
+		}
+		return leftVal.Rem(rightVal)
+	case "**":
+		return evalDecimalPower(leftVal, rightVal)
+	case "<":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) == -1)
+	case ">":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) == 1)
+	case "==":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) == 0)
+	case "!=":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) != 0)
+	case "<=":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) <= 0)
+	case ">=":
+		return nativeBoolToBooleanObject(leftVal.Compare(rightVal) >= 0)
+	default:
+		return object.NewErrorFormat("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
+
+// divisionScale is the scale of a division, it is at least DecimalDivisionScale
+func divisionScale(left, right *object.Decimal) int32 {
+	scale := object.DecimalDivisionScale
+	if left.Scale > scale {
+		scale = left.Scale
+	}
+	if right.Scale > scale {
+		scale = right.Scale
+	}
+	return scale
+}
+
+// evalDecimalPower raise decimal to an integer exponent, a negative exponent
+// is same as 1 / left ** -right
+func evalDecimalPower(left, right *object.Decimal) object.Object {
+	exponent := right.Round(0, object.ROUND_DOWN)
+	if right.Compare(exponent) != 0 || !exponent.Value.IsInt64() {
+		return object.NewErrorFormat("decimal exponent must be an integer: %s ** %s", left, right)
+	}
+
+	n := exponent.Value.Int64()
+	if n < 0 {
+		n = -n
+	}
+
+	if (left.Scale > 0 && n > object.MaxDecimalScale/int64(left.Scale)) || !powerFits(left.Value, big.NewInt(n)) {
+		return object.NewErrorFormat("decimal exponent too large: %s ** %s", left, right)
+	}
+
+	result := left.Pow(n)
+	if exponent.Value.Sign() >= 0 {
+		return result
+	}
+
+	if result.Value.Sign() == 0 {
+		return object.NewErrorFormat("division by zero: %s ** %s", left, right)
+	}
+
+	one, _ := object.ToDecimal(&object.Integer{Value: 1})
+	return one.Quo(result, divisionScale(one, result), object.DefaultRoundingMode)
+}
@@ -0,0 +1,146 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestDecimalExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12.30d", "12.30"},
+		{"5d", "5"},
+		{"-1.5d", "-1.5"},
+		{"0.1d + 0.2d", "0.3"},
+		{"1.10d + 2", "3.10"},
+		{"10d - 0.01d", "9.99"},
+		{"1.5d * 1.5d", "2.25"},
+		{"1.5d * 2", "3.0"},
+		{"1.5d + 0.1", "1.6"},
+		{"1d / 3d", "0.3333333333333333"},
+		{"2d / 3d", "0.6666666666666667"},
+		{"10.00d / 4", "2.50"},
+		{"1d / 8", "0.125"},
+		{"7.5d % 2", "1.5"},
+		{"-7.5d % 2", "-1.5"},
+		{"1.1d ** 2", "1.21"},
+		{"2d ** -2", "0.25"},
+		{"var a = 1.5d; a++; a;", "2.5"},
+		{"var a = 1.5d; --a;", "0.5"},
+		{"123456789012345678901234567890.10d + 0.01d", "123456789012345678901234567890.11"},
+		{"decimal(\"12.30\")", "12.30"},
+		{"decimal(\"-1.5e2\")", "-150"},
+		{"decimal(\"1.5e-2\")", "0.015"},
+		{"decimal(10)", "10"},
+		{"decimal(0.1)", "0.1"},
+		{"decimal(2 ** 64)", "18446744073709551616"},
+		{"decimal(1.5d)", "1.5"},
+		{"decimal(\"12.345\", 2)", "12.35"},
+		{"decimal(\"12.345\", 2, \"halfEven\")", "12.34"},
+		{"decimal(1, 2)", "1.00"},
+		{"2.5d.round()", "3"},
+		{"2.5d.round(0, \"halfUp\")", "3"},
+		{"2.5d.round(0, \"halfDown\")", "2"},
+		{"2.5d.round(0, \"halfEven\")", "2"},
+		{"3.5d.round(0, \"halfEven\")", "4"},
+		{"2.1d.round(0, \"up\")", "3"},
+		{"2.9d.round(0, \"down\")", "2"},
+		{"(-2.1d).round(0, \"ceiling\")", "-2"},
+		{"(-2.1d).round(0, \"floor\")", "-3"},
+		{"(-2.5d).round(0, \"halfUp\")", "-3"},
+		{"(-2.5d).round(0, \"halfEven\")", "-2"},
+		{"1.005d.round(2)", "1.01"},
+		{"1.5d.round(3)", "1.500"},
+		{"(-1.5d).abs()", "1.5"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestDecimalExpression[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			decimal, ok := evaluated.(*object.Decimal)
+			if !ok {
+				t.Fatalf("object is not Decimal. got=%T (%+v)", evaluated, evaluated)
+			}
+
+			if decimal.Inspect() != tt.expected {
+				t.Errorf("object has wrong value. got=%s, want=%s", decimal.Inspect(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecimalConversionAndComparison(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"1.10d == 1.1d", true},
+		{"1.10d != 1.1d", false},
+		{"1.5d < 2", true},
+		{"2 > 1.5d", true},
+		{"1.5d >= 1.50d", true},
+		{"0.1d + 0.2d == 0.3d", true},
+		{"0.1d + 0.2d == 0.3", true},
+		{"12.30d.type()", "DECIMAL"},
+		{"12.30d.string()", "12.30"},
+		{"12.99d.int()", 12},
+		{"(-12.99d).int()", -12},
+		{"12.5d.float()", 12.5},
+		{"12.30d.scale()", 2},
+		{"{1.0d: \"one\"}[1.00d]", "one"},
+		{"function (a: decimal): number { return a; }(1.5d) == 1.5d", true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestDecimalConversionAndComparison[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestDecimalErrors(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{"1.5d / 0", "division by zero: 1.5 / 0"},
+		{"1.5d % 0d", "division by zero: 1.5 % 0"},
+		{"0d ** -1", "division by zero: 0 ** -1"},
+		{"1.5d ** 0.5", "decimal exponent must be an integer: 1.5 ** 0.5"},
+		{"1.5d + 1.0 / 0.0", "could not convert +Inf to decimal: DECIMAL + FLOAT"},
+		{"decimal(\"abc\")", "ValueError: decimal() could not parse \"abc\" as decimal"},
+		{"decimal(true)", "TypeError: decimal() expected argument #1 to be `number or string` got `BOOLEAN`"},
+		{"decimal(1, \"2\")", "TypeError: decimal() expected argument #2 to be `INTEGER` got `STRING`"},
+		{"decimal(1, -1)", "ValueError: decimal() scale must not be negative got -1"},
+		{"decimal(1, 2000000000)", "ValueError: decimal() scale 2000000000 is larger than 1000000"},
+		{"1.5d.round(1000001)", "ValueError: decimal.round() scale 1000001 is larger than 1000000"},
+		{"decimal(\"1e1000000000\")", "ValueError: decimal() could not parse \"1e1000000000\" as decimal, scale must be between -1000000 and 1000000"},
+		{"decimal(\"1e-1000001\")", "ValueError: decimal() could not parse \"1e-1000001\" as decimal, scale must be between -1000000 and 1000000"},
+		{"decimal(\"1e-600000\") * decimal(\"1e-600000\")", "ValueError: decimal scale 1200000 is larger than 1000000"},
+		{"0.01d ** 9223372036854775807", "decimal exponent too large: 0.01 ** 9223372036854775807"},
+		{"10d ** 100000000", "decimal exponent too large: 10 ** 100000000"},
+		{"1.5d.round(0, \"nearest\")", "ValueError: unknown rounding mode nearest, expected one of halfUp, halfDown, halfEven, up, down, ceiling, floor"},
+		{"1.5d.round(1, 2)", "TypeError: decimal.round() expected argument #2 to be `STRING` got `INTEGER`"},
+		{"1.5d.ups()", "method ups not exists on decimal object."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestDecimalErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package evaluator
 import (
 	"github.com/gravataLonga/ninja/ast"
 	"github.com/gravataLonga/ninja/object"
+	"strings"
 )
 
 func evalDelete(left ast.Expression, index object.Object, env *object.Environment) object.Object {
@@ -17,6 +18,10 @@ func evalDelete(left ast.Expression, index object.Object, env *object.Environmen
 		return object.NewErrorFormat("DeleteStatement.left %s identifier not found.", ident.Value)
 	}
 
+	if object.IsFrozen(value) {
+		return object.NewErrorFormat("TypeError: cannot modify frozen %s", strings.ToLower(string(value.Type())))
+	}
+
 	switch value.(type) {
 	case *object.Array:
 		arr, _ := value.(*object.Array)
@@ -28,11 +33,11 @@ func evalDelete(left ast.Expression, index object.Object, env *object.Environmen
 		env.Set(ident.Value, arr)
 	case *object.Hash:
 		hash, _ := value.(*object.Hash)
-		hashable, ok := index.(object.Hashable)
+		key, ok := object.HashKeyOf(index)
 		if !ok {
 			return object.NewErrorFormat("DeleteStatement.index must be a Hashable. Got: %T", index)
 		}
-		delete(hash.Pairs, hashable.HashKey())
+		hash.Delete(key)
 
 		env.Set(ident.Value, hash)
 	default:
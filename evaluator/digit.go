@@ -3,12 +3,18 @@ package evaluator
 import (
 	"github.com/gravataLonga/ninja/ast"
 	"github.com/gravataLonga/ninja/object"
+	"math"
+	"math/big"
 )
 
 func evalFloatOrIntegerInfixExpression(
 	operator string,
 	left, right object.Object,
 ) object.Object {
+	if left.Type() == object.DECIMAL_OBJ || right.Type() == object.DECIMAL_OBJ {
+		return evalDecimalInfixExpression(operator, left, right)
+	}
+
 	leftValFloat, _ := left.(*object.Float)
 	rightValFloat, _ := right.(*object.Float)
 
@@ -19,6 +25,21 @@ func evalFloatOrIntegerInfixExpression(
 		return evalIntegerInfixExpression(operator, leftValInteger, rightValInteger)
 	}
 
+	leftValBig, okLeftBig := left.(*object.BigInt)
+	rightValBig, okRightBig := right.(*object.BigInt)
+
+	if (okLeftInteger || okLeftBig) && (okRightInteger || okRightBig) {
+		return evalBigIntInfixExpression(operator, left, right)
+	}
+
+	if okLeftBig {
+		leftValFloat = &object.Float{Value: leftValBig.Float()}
+	}
+
+	if okRightBig {
+		rightValFloat = &object.Float{Value: rightValBig.Float()}
+	}
+
 	if okLeftInteger {
 		leftValFloat = &object.Float{
 			Value: float64(leftValInteger.Value),
@@ -37,21 +58,33 @@ func evalFloatOrIntegerInfixExpression(
 func evalMinusPrefixOperatorExpression(node *ast.PrefixExpression, right object.Object) object.Object {
 	if right.Type() == object.INTEGER_OBJ {
 		value := right.(*object.Integer).Value
+		if value == math.MinInt64 {
+			return object.NewInteger(new(big.Int).Neg(big.NewInt(value)))
+		}
 		return &object.Integer{Value: -value}
 	}
 
+	if right.Type() == object.BIGINT_OBJ {
+		value := right.(*object.BigInt).Value
+		return object.NewInteger(new(big.Int).Neg(value))
+	}
+
 	if right.Type() == object.FLOAT_OBJ {
 		value := right.(*object.Float).Value
 		return &object.Float{Value: -value}
 	}
 
+	if right.Type() == object.DECIMAL_OBJ {
+		value := right.(*object.Decimal)
+		return object.NewDecimal(new(big.Int).Neg(value.Value), value.Scale)
+	}
+
 	return object.NewErrorFormat("unknown operator: -%s %s", right.Type(), node.Token)
 }
 
 func evalIncrementExpression(right object.Object) object.Object {
-	if right.Type() == object.INTEGER_OBJ {
-		value := right.(*object.Integer).Value
-		return &object.Integer{Value: value + 1}
+	if right.Type() == object.INTEGER_OBJ || right.Type() == object.BIGINT_OBJ || right.Type() == object.DECIMAL_OBJ {
+		return evalFloatOrIntegerInfixExpression("+", right, &object.Integer{Value: 1})
 	}
 
 	if right.Type() == object.FLOAT_OBJ {
@@ -63,9 +96,8 @@ func evalIncrementExpression(right object.Object) object.Object {
 }
 
 func evalDecrementExpression(right object.Object) object.Object {
-	if right.Type() == object.INTEGER_OBJ {
-		value := right.(*object.Integer).Value
-		return &object.Integer{Value: value - 1}
+	if right.Type() == object.INTEGER_OBJ || right.Type() == object.BIGINT_OBJ || right.Type() == object.DECIMAL_OBJ {
+		return evalFloatOrIntegerInfixExpression("-", right, &object.Integer{Value: 1})
 	}
 
 	if right.Type() == object.FLOAT_OBJ {
@@ -6,34 +6,114 @@ import (
 )
 
 func evalEnumStatement(node *ast.EnumStatement, env *object.Environment) object.Object {
-	enum := &object.Enum{Branches: map[string]object.Object{}}
-	for i, v := range node.Branches {
-		enum.Branches[i] = Eval(v, env)
-	}
-
 	ident, ok := node.Identifier.(*ast.Identifier)
 	if !ok {
 		return object.NewErrorFormat("expected identifier. got: %s", node.Identifier)
 	}
 
+	enum := &object.Enum{
+		Name:     ident.Value,
+		Branches: map[string]object.Object{},
+		Methods:  map[string]*object.FunctionLiteral{},
+	}
+
+	for _, c := range node.Cases {
+		var value object.Object
+		if c.Value != nil {
+			value = Eval(c.Value, env)
+			if object.IsError(value) {
+				return value
+			}
+		}
+
+		var fields []string
+		if c.Parameters != nil {
+			fields = make([]string, len(c.Parameters))
+			for i, p := range c.Parameters {
+				fields[i] = p.Value
+			}
+		}
+
+		// branches are constant values, so arrays and hashes can't be mutated
+		enum.AddCase(c.Name, object.Freeze(value), fields)
+	}
+
+	for _, m := range node.Methods {
+		enum.Methods[m.Name.Value] = &object.FunctionLiteral{Parameters: m.Parameters, Body: m.Body, Env: env, ReturnType: m.ReturnType}
+	}
+
 	env.Set(ident.Value, enum)
 	return enum
 }
 
-func evalScopeOperatorExpression(node *ast.ScopeOperatorExpression, env *object.Environment) object.Object {
-	access, ok := node.AccessIdentifier.(*ast.Identifier)
-	if !ok {
-		return object.NewErrorFormat("expected access identifier. got: %s", node.AccessIdentifier)
+// evalEnumInfixExpression compare two cases, equality take in account associated
+// data and ordering is given by declaration order of cases.
+func evalEnumInfixExpression(
+	operator string,
+	left, right object.Object,
+) object.Object {
+	leftValue := left.(*object.EnumValue)
+	rightValue := right.(*object.EnumValue)
+
+	switch operator {
+	case "==":
+		return nativeBoolToBooleanObject(leftValue.Equal(rightValue))
+	case "!=":
+		return nativeBoolToBooleanObject(!leftValue.Equal(rightValue))
+	}
+
+	if leftValue.Enum != rightValue.Enum {
+		return object.NewErrorFormat("type mismatch: %s %s %s", leftValue.Enum.Name, operator, rightValue.Enum.Name)
 	}
 
+	switch operator {
+	case "<":
+		return nativeBoolToBooleanObject(leftValue.Compare(rightValue) == -1)
+	case ">":
+		return nativeBoolToBooleanObject(leftValue.Compare(rightValue) == 1)
+	case "<=":
+		return nativeBoolToBooleanObject(leftValue.Compare(rightValue) <= 0)
+	case ">=":
+		return nativeBoolToBooleanObject(leftValue.Compare(rightValue) >= 0)
+	}
+
+	return object.NewErrorFormat("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+}
+
+// enumMethod find method declared on enum which can be called on enum itself or any of it is cases.
+func enumMethod(obj object.Object, name string) (*object.FunctionLiteral, bool) {
+	var enum *object.Enum
+	switch obj := obj.(type) {
+	case *object.Enum:
+		enum = obj
+	case *object.EnumValue:
+		enum = obj.Enum
+	default:
+		return nil, false
+	}
+
+	fn, ok := enum.Methods[name]
+	return fn, ok
+}
+
+// applyMethod call fn with "self" bound to receiver
+func applyMethod(fn *object.FunctionLiteral, self object.Object, args []object.Object) object.Object {
+	env := object.NewEnclosedEnvironment(fn.Env)
+	env.SetConstant("self", self)
+
+	bound := &object.FunctionLiteral{Parameters: fn.Parameters, Body: fn.Body, Env: env, ReturnType: fn.ReturnType}
+	return applyFunction(bound, args)
+}
+
+func evalScopeOperatorExpression(node *ast.ScopeOperatorExpression, env *object.Environment) object.Object {
 	property, ok := node.PropertyIdentifier.(*ast.Identifier)
 	if !ok {
 		return object.NewErrorFormat("expected property identifier. got: %s", node.PropertyIdentifier)
 	}
 
-	v, ok := env.Get(access.Value)
-	if !ok {
-		return object.NewErrorFormat("identifier not found: " + access.Value)
+	v, err := evalScopeAccess(node.AccessIdentifier, env)
+	if err != nil {
+		return err
 	}
 
 	enum, ok := v.(*object.Enum)
@@ -41,10 +121,30 @@ func evalScopeOperatorExpression(node *ast.ScopeOperatorExpression, env *object.
 		return object.NewErrorFormat("identifier must be accessible with :: got: %s", v)
 	}
 
-	brancheValue, ok := enum.Branches[property.Value]
+	brancheValue, ok := enum.Case(property.Value)
 	if !ok {
 		return object.NewErrorFormat("identifier %s don't exists on enum object", property.Value)
 	}
 
 	return brancheValue
 }
+
+// evalScopeAccess resolve left side of "::", it is an identifier or an enum
+// exported by a module, e.g.: lib.Color::RED
+func evalScopeAccess(node ast.Expression, env *object.Environment) (object.Object, object.Object) {
+	switch access := node.(type) {
+	case *ast.Identifier:
+		v, ok := env.Get(access.Value)
+		if !ok {
+			return nil, object.NewErrorFormat("identifier not found: " + access.Value)
+		}
+		return v, nil
+	case *ast.Dot:
+		v := Eval(access, env)
+		if object.IsError(v) {
+			return nil, v
+		}
+		return v, nil
+	}
+	return nil, object.NewErrorFormat("expected access identifier. got: %s", node)
+}
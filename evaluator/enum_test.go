@@ -2,6 +2,7 @@ package evaluator
 
 import (
 	"fmt"
+	"github.com/gravataLonga/ninja/object"
 	"testing"
 )
 
@@ -11,19 +12,19 @@ func TestEnum(t *testing.T) {
 		expectedValue interface{}
 	}{
 		{
-			`enum status { case OK:1; case NOK:2;}; status::OK;`,
+			`enum status { case OK:1; case NOK:2;}; status::OK.value();`,
 			1,
 		},
 		{
-			`enum status { case OK: if (true) { 1 } else { 0 }; case NOK:2;}; status::OK;`,
+			`enum status { case OK: if (true) { 1 } else { 0 }; case NOK:2;}; status::OK.value();`,
 			1,
 		},
 		{
-			`enum status { case OK: "OK"; case NOK:"NOK";}; status::OK;`,
+			`enum status { case OK: "OK"; case NOK:"NOK";}; status::OK.value();`,
 			"OK",
 		},
 		{
-			`enum status { case OK: true; case NOK:false;}; status::OK;`,
+			`enum status { case OK: true; case NOK:false;}; status::OK.value();`,
 			true,
 		},
 	}
@@ -36,3 +37,151 @@ func TestEnum(t *testing.T) {
 		})
 	}
 }
+
+// cases are enum values whether enum declare methods or not
+func TestEnumCaseWithAndWithoutMethods(t *testing.T) {
+	enums := []string{
+		`enum STATUS { case OK: 200; case NOT_FOUND: 404; };`,
+		`enum STATUS { case OK: 200; case NOT_FOUND: 404; function label() { return self.name().lower(); } };`,
+	}
+
+	tests := []struct {
+		input         string
+		expectedValue interface{}
+	}{
+		{`STATUS::OK.type();`, "STATUS"},
+		{`STATUS::OK.value();`, 200},
+		{`STATUS::OK.value() + 1;`, 201},
+		{`STATUS::OK.name();`, "OK"},
+		{`STATUS::NOT_FOUND.ordinal();`, 1},
+		{`STATUS::OK == STATUS::OK;`, true},
+		{`STATUS::OK == 200;`, false},
+		{`STATUS::OK.value() == 200;`, true},
+		{`STATUS::OK < STATUS::NOT_FOUND;`, true},
+		{`STATUS.from(404) == STATUS::NOT_FOUND;`, true},
+		{`STATUS.cases()[0] == STATUS::OK;`, true},
+	}
+
+	for i, enum := range enums {
+		for j, tt := range tests {
+			t.Run(fmt.Sprintf("TestEnumCaseWithAndWithoutMethods[%d][%d]", i, j), func(t *testing.T) {
+				eval := testEval(enum+tt.input, t)
+
+				testObjectLiteral(t, eval, tt.expectedValue)
+			})
+		}
+	}
+}
+
+func TestEnumAlgebraic(t *testing.T) {
+	shape := `enum Shape {
+	case Circle(radius);
+	case Rect(width, height);
+	case Empty;
+	function area() {
+		if (self.name() == "Circle") { return 3 * self.radius() * self.radius(); }
+		if (self.name() == "Rect") { return self.width() * self.height(); }
+		return 0;
+	}
+	function describe(prefix) {
+		return prefix + self.type();
+	}
+};
+`
+	status := `enum STATUS {
+	case OK: 200;
+	case NOT_FOUND: 404;
+	case ERROR: 500;
+	function label() { return self.name().lower(); }
+};
+`
+
+	tests := []struct {
+		input         string
+		expectedValue interface{}
+	}{
+		{shape + `Shape::Circle(2).area();`, 12},
+		{shape + `Shape::Rect(2, 5).area();`, 10},
+		{shape + `Shape::Empty.area();`, 0},
+		{shape + `Shape::Circle(2).radius();`, 2},
+		{shape + `Shape::Rect(2, 5).payload();`, []int{2, 5}},
+		{shape + `Shape::Circle(2).type();`, "Shape"},
+		{shape + `Shape.type();`, "ENUM"},
+		{shape + `Shape.name();`, "Shape"},
+		{shape + `Shape::Empty.describe("is ");`, "is Shape"},
+		{shape + `Shape.describe("enum ");`, "enum ENUM"},
+		{shape + `Shape::Circle(2) == Shape::Circle(2);`, true},
+		{shape + `Shape::Circle(2) == Shape::Circle(3);`, false},
+		{shape + `Shape::Circle(2) != Shape::Rect(2, 2);`, true},
+		{shape + `Shape::Empty == Shape::Empty;`, true},
+		{shape + `Shape::Circle(1) < Shape::Empty;`, true},
+		{shape + `Shape::Empty.value();`, nil},
+		{status + `STATUS::OK.value();`, 200},
+		{status + `STATUS::OK.ordinal();`, 0},
+		{status + `STATUS::ERROR.ordinal();`, 2},
+		{status + `STATUS::OK.name();`, "OK"},
+		{status + `STATUS::NOT_FOUND.label();`, "not_found"},
+		{status + `STATUS::OK < STATUS::NOT_FOUND;`, true},
+		{status + `STATUS::ERROR >= STATUS::NOT_FOUND;`, true},
+		{status + `STATUS::ERROR <= STATUS::OK;`, false},
+		{status + `STATUS.from(404) == STATUS::NOT_FOUND;`, true},
+		{status + `STATUS.tryFrom(404).name();`, "NOT_FOUND"},
+		{status + `STATUS.tryFrom(403);`, nil},
+		{status + `STATUS.cases().length();`, 3},
+		{status + `STATUS.cases()[1] == STATUS::NOT_FOUND;`, true},
+		{status + `var names = ""; var cases = STATUS.cases(); for (var i = 0; i < cases.length(); i++) { names = names + cases[i].name(); } names;`, "OKNOT_FOUNDERROR"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestEnumAlgebraic[%d]", i), func(t *testing.T) {
+			eval := testEval(tt.input, t)
+
+			if expected, ok := tt.expectedValue.([]int); ok {
+				arr, ok := eval.(*object.Array)
+				if !ok {
+					t.Fatalf("object is not Array. got=%T (%+v)", eval, eval)
+				}
+
+				for i, v := range expected {
+					testIntegerObject(t, arr.Elements[i], int64(v))
+				}
+				return
+			}
+
+			testObjectLiteral(t, eval, tt.expectedValue)
+		})
+	}
+}
+
+func TestEnumAlgebraicErrors(t *testing.T) {
+	shape := `enum Shape { case Circle(radius); case Empty; };`
+	status := `enum STATUS { case OK: 200; };`
+
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{shape + `Shape::Circle(1, 2);`, "TypeError: Shape::Circle() takes exactly 1 argument (2 given)"},
+		{shape + `Shape::Empty(1);`, "not a function: Shape::Empty"},
+		{shape + `Shape::Circle(1).width();`, "method width not exists on enum Shape object."},
+		{shape + `Shape::Square;`, "identifier Square don't exists on enum object"},
+		{shape + status + `Shape::Empty < STATUS::OK;`, "type mismatch: Shape < STATUS"},
+		{status + `STATUS.from(201);`, "ValueError: 201 is not a valid value for enum STATUS"},
+		{status + `STATUS.unknown();`, "method unknown not exists on enum object."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestEnumAlgebraicErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedMessage, errObj.Message)
+			}
+		})
+	}
+}
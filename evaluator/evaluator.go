@@ -17,14 +17,21 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		return evalDelete(node.Left, Eval(node.Index, env), env)
 	case *ast.Import:
 		return evalImport(node, env)
+	case *ast.ExportStatement:
+		return Eval(node.Statement, env)
 	case *ast.Identifier:
 		return evalIdentifier(node, env)
 	case *ast.VarStatement:
+		if env.IsLocalConstant(node.Name.Value) {
+			return object.NewErrorFormat("cannot redeclare constant %s %s", node.Name.Value, node.Name.Token)
+		}
 		val := Eval(node.Value, env)
 		if object.IsError(val) {
 			return val
 		}
 		env.Set(node.Name.Value, val)
+	case *ast.ConstStatement:
+		return evalConstStatement(node, env)
 	case *ast.AssignStatement:
 		return evalAssignStatement(node, env)
 	case *ast.PrefixExpression:
@@ -62,14 +69,27 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 	case *ast.FunctionLiteral:
 		params := node.Parameters
 		body := node.Body
-		fn := &object.FunctionLiteral{Parameters: params, Env: env, Body: body}
+		fn := &object.FunctionLiteral{Parameters: params, Env: env, Body: body, ReturnType: node.ReturnType}
 		if node.Name != nil {
+			if env.IsLocalConstant(node.Name.Value) {
+				return object.NewErrorFormat("cannot redeclare constant %s %s", node.Name.Value, node.Name.Token)
+			}
 			env.Set(node.Name.Value, fn)
 		}
 		return fn
 
+	case *ast.MacroLiteral:
+		return object.NewErrorFormat("macro must be declared at top level %s", node.Token)
+
 	// CallFunctionNode
 	case *ast.CallExpression:
+		if isCallTo(node, "quote") {
+			if len(node.Arguments) != 1 {
+				return object.NewErrorFormat("TypeError: quote() takes exactly 1 argument (%d given)", len(node.Arguments))
+			}
+			return quote(node.Arguments[0], env)
+		}
+
 		function := Eval(node.Function, env)
 		if object.IsError(function) {
 			return function
@@ -95,12 +115,19 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 
 		// IntegerLiteral
 	case *ast.IntegerLiteral:
+		if node.Big != nil {
+			return &object.BigInt{Value: node.Big}
+		}
 		return &object.Integer{Value: node.Value}
 
 		// FloatLiteral
 	case *ast.FloatLiteral:
 		return &object.Float{Value: node.Value}
 
+		// DecimalLiteral
+	case *ast.DecimalLiteral:
+		return object.NewDecimal(node.Value, node.Scale)
+
 		// Boolean
 	case *ast.Boolean:
 		return nativeBoolToBooleanObject(node.Value)
@@ -132,6 +159,10 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		// Hash
 	case *ast.HashLiteral:
 		return evalHashLiteral(node, env)
+
+		// Set
+	case *ast.SetLiteral:
+		return evalSetLiteral(node, env)
 	case *ast.ForStatement:
 		return evalForStatement(node, env)
 	case *ast.Dot:
@@ -0,0 +1,192 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"github.com/gravataLonga/ninja/stdlib"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFsModule(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`fs.write(dir + "/a.txt", "hello"); fs.read(dir + "/a.txt")`, "hello"},
+		{`fs.write(dir + "/a.txt", "hello"); fs.append(dir + "/a.txt", " world"); fs.read(dir + "/a.txt")`, "hello world"},
+		{`fs.append(dir + "/new.txt", "a"); fs.read(dir + "/new.txt")`, "a"},
+		{`[fs.exists(dir), fs.exists(dir + "/nope")]`, "[true, false]"},
+		{`fs.write(dir + "/a.txt", "abc"); var s = fs.stat(dir + "/a.txt"); [s["name"], s["size"], s["isDir"], s["mode"]]`, "[a.txt, 3, false, -rw-r--r--]"},
+		{`fs.stat(dir)["isDir"]`, "true"},
+		{`fs.mkdir(dir + "/a/b/c"); fs.stat(dir + "/a/b/c")["isDir"]`, "true"},
+		{`fs.mkdir(dir + "/b"); fs.write(dir + "/c.txt", ""); fs.write(dir + "/a.txt", ""); fs.listDir(dir)`, "[a.txt, b, c.txt]"},
+		{`fs.write(dir + "/a.txt", ""); fs.rename(dir + "/a.txt", dir + "/b.txt"); [fs.exists(dir + "/a.txt"), fs.exists(dir + "/b.txt")]`, "[false, true]"},
+		{`fs.write(dir + "/a.txt", ""); fs.remove(dir + "/a.txt"); fs.exists(dir + "/a.txt")`, "false"},
+		{`fs.mkdir(dir + "/a/b"); fs.remove(dir + "/a", true); fs.exists(dir + "/a")`, "false"},
+		{`fs.write(dir + "/a.json", ""); fs.write(dir + "/b.txt", ""); fs.glob(dir + "/*.json").map(function(p) { return p.replace(dir, "") })`, "[/a.json]"},
+		{`fs.mkdir(dir + "/a"); fs.write(dir + "/a/b.txt", ""); fs.walk(dir).map(function(p) { return p.replace(dir, "") })`, "[/a, /a/b.txt]"},
+		{`fs.mkdir(dir + "/a"); fs.write(dir + "/a/b.txt", ""); var found = []; fs.walk(dir, function(p) { found.push(p.replace(dir, "")) }); found`, "[/a, /a/b.txt]"},
+		{`var tmp = fs.tempDir(); var ok = fs.stat(tmp)["isDir"]; fs.remove(tmp); ok`, "true"},
+		{`fs.type()`, "MODULE"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFsModule[%d]", i), func(t *testing.T) {
+			input := fmt.Sprintf("var dir = %q; %s", filepath.ToSlash(t.TempDir()), tt.input)
+			evaluated := testEval(input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestFsModuleWrongUsage(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`fs.read()`, "TypeError: fs.read() takes exactly 1 argument (0 given)"},
+		{`fs.read(1)`, "TypeError: fs.read() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`fs.read(dir + "/nope")`, "IOError: fs.read() open DIR/nope: no such file or directory"},
+		{`fs.write(dir + "/a.txt", 1)`, "TypeError: fs.write() expected argument #2 to be `STRING` got `INTEGER`"},
+		{`fs.write(dir + "/nope/a.txt", "")`, "IOError: fs.write() open DIR/nope/a.txt: no such file or directory"},
+		{`fs.stat(dir + "/nope")`, "IOError: fs.stat() stat DIR/nope: no such file or directory"},
+		{`fs.remove(dir + "/nope")`, "IOError: fs.remove() remove DIR/nope: no such file or directory"},
+		{`fs.remove(dir + "/nope", true)`, "IOError: fs.remove() stat DIR/nope: no such file or directory"},
+		{`fs.rename(dir + "/nope", dir + "/a")`, "IOError: fs.rename() rename DIR/nope DIR/a: no such file or directory"},
+		{`fs.listDir(dir + "/nope")`, "IOError: fs.listDir() open DIR/nope: no such file or directory"},
+		{`fs.glob("[")`, "ValueError: fs.glob() syntax error in pattern"},
+		{`fs.walk(dir, 1)`, "TypeError: fs.walk() expected argument #2 to be `FUNCTION` got `INTEGER`"},
+		{`fs.mkdir(dir + "/a"); fs.walk(dir, function(p) { return [].foo() })`, "method foo not exists on array object."},
+		{`fs.delete(dir)`, "method delete not exists on module fs."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFsModuleWrongUsage[%d]", i), func(t *testing.T) {
+			dir := filepath.ToSlash(t.TempDir())
+			evaluated := testEval(fmt.Sprintf("var dir = %q; %s", dir, tt.input), t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			expected := strings.ReplaceAll(tt.expectedErrorMessage, "DIR", dir)
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. expected=%q, got=%q", expected, errObj.Message)
+			}
+		})
+	}
+}
+
+func TestFsModuleWalkStopOnError(t *testing.T) {
+	dir := t.TempDir()
+	log := filepath.Join(t.TempDir(), "log")
+	os.MkdirAll(filepath.Join(dir, "a"), 0755)
+	os.MkdirAll(filepath.Join(dir, "b"), 0755)
+	os.WriteFile(filepath.Join(dir, "b", "3"), []byte(""), 0644)
+
+	input := fmt.Sprintf(`fs.walk(%q, function(p) { fs.append(%q, p + "\n"); return [].foo(); })`, dir, log)
+	evaluated := testEval(input, t)
+
+	if !object.IsError(evaluated) {
+		t.Fatalf("expected error. Got: %s", evaluated.Inspect())
+	}
+
+	visited, _ := os.ReadFile(log)
+	if string(visited) != filepath.Join(dir, "a")+"\n" {
+		t.Errorf("expected function to not be called after error. Called with: %q", visited)
+	}
+}
+
+func TestFsModuleFileSystemRootIsResolvedOnce(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("secret"), 0644)
+
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	os.Chdir(root)
+
+	original := object.FileSystemRoot
+	defer func() { object.FileSystemRoot = original }()
+	if err := stdlib.SetFileSystemRoot("."); err != nil {
+		t.Fatalf("unable to set root. Got: %v", err)
+	}
+
+	if object.FileSystemRoot != root {
+		t.Fatalf("expected root to be %q. Got: %q", root, object.FileSystemRoot)
+	}
+
+	evaluated := testEval(fmt.Sprintf(`os.chdir(%q); fs.read("secret.txt")`, outside), t)
+	expected := fmt.Sprintf("ERROR: PermissionError: fs.read() path secret.txt is outside of %s", root)
+	if evaluated.Inspect() != expected {
+		t.Errorf("expected %q. Got: %q", expected, evaluated.Inspect())
+	}
+}
+
+func TestFsModuleSetFileSystemRootErrors(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+
+	original := object.FileSystemRoot
+	defer func() { object.FileSystemRoot = original }()
+
+	for i, root := range []string{filepath.Join(dir, "nope"), filepath.Join(dir, "a.txt")} {
+		t.Run(fmt.Sprintf("TestFsModuleSetFileSystemRootErrors[%d]", i), func(t *testing.T) {
+			if err := stdlib.SetFileSystemRoot(root); err == nil {
+				t.Errorf("expected error for root %q", root)
+			}
+		})
+	}
+}
+
+func TestFsModuleFileSystemRoot(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	os.WriteFile(filepath.Join(outside, "a.txt"), []byte("secret"), 0644)
+	os.Mkdir(filepath.Join(root, "dir"), 0755)
+	os.Symlink(outside, filepath.Join(root, "link"))
+	os.Symlink(filepath.Join(outside, "a.txt"), filepath.Join(root, "secret.txt"))
+	os.Symlink(filepath.Join(root, "dir"), filepath.Join(root, "inner"))
+
+	original := object.FileSystemRoot
+	defer func() { object.FileSystemRoot = original }()
+	if err := stdlib.SetFileSystemRoot(root); err != nil {
+		t.Fatalf("unable to set root. Got: %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{fmt.Sprintf(`fs.write(%q, "a"); fs.read(%q)`, root+"/a.txt", root+"/a.txt"), "a"},
+		{fmt.Sprintf(`fs.read(%q)`, outside+"/a.txt"), fmt.Sprintf("ERROR: PermissionError: fs.read() path %s/a.txt is outside of %s", outside, root)},
+		{fmt.Sprintf(`fs.read(%q)`, root+"/../a.txt"), fmt.Sprintf("ERROR: PermissionError: fs.read() path %s/../a.txt is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.rename(%q, %q)`, root+"/a.txt", outside+"/b.txt"), fmt.Sprintf("ERROR: PermissionError: fs.rename() path %s/b.txt is outside of %s", outside, root)},
+		{fmt.Sprintf(`fs.glob(%q)`, outside+"/*"), "[]"},
+		{fmt.Sprintf(`fs.read(%q)`, root+"/link/a.txt"), fmt.Sprintf("ERROR: PermissionError: fs.read() path %s/link/a.txt is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.read(%q)`, root+"/secret.txt"), fmt.Sprintf("ERROR: PermissionError: fs.read() path %s/secret.txt is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.write(%q, "a")`, root+"/link/new.txt"), fmt.Sprintf("ERROR: PermissionError: fs.write() path %s/link/new.txt is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.mkdir(%q)`, root+"/link/a/b"), fmt.Sprintf("ERROR: PermissionError: fs.mkdir() path %s/link/a/b is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.read(%q)`, root+"/inner/../../a.txt"), fmt.Sprintf("ERROR: PermissionError: fs.read() path %s/inner/../../a.txt is outside of %s", root, root)},
+		{fmt.Sprintf(`fs.write(%q, "b"); fs.read(%q)`, root+"/inner/b.txt", root+"/dir/b.txt"), "b"},
+		{fmt.Sprintf(`json.decoder({"file": %q})`, root+"/secret.txt"), fmt.Sprintf("ERROR: PermissionError: json.decoder() path %s/secret.txt is outside of %s", root, root)},
+		{`fs.tempDir().startsWith(` + fmt.Sprintf("%q", root) + `)`, "true"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFsModuleFileSystemRoot[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
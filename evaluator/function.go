@@ -7,6 +7,10 @@ import (
 	"github.com/gravataLonga/ninja/object"
 )
 
+func init() {
+	object.ApplyFunction = applyFunction
+}
+
 func applyFunction(fn object.Object, args []object.Object) object.Object {
 
 	switch fn := fn.(type) {
@@ -14,11 +18,25 @@ func applyFunction(fn object.Object, args []object.Object) object.Object {
 		if err := argumentsIsValid(args, fn.Parameters); err != nil {
 			return object.NewErrorFormat(err.Error()+" at %s", fn.Body.Token)
 		}
+		if err := argumentsMatchTypes(args, fn.Parameters); err != nil {
+			return object.NewErrorFormat(err.Error()+" at %s", fn.Body.Token)
+		}
 		extendedEnv := extendFunctionEnv(fn.Env, fn.Parameters, args)
-		evaluated := evalBlockStatement(fn.Body, extendedEnv)
-		return unwrapReturnValue(evaluated)
+		evaluated := unwrapReturnValue(evalBlockStatement(fn.Body, extendedEnv))
+		if object.IsError(evaluated) || fn.ReturnType == nil {
+			return evaluated
+		}
+		if !object.MatchAnnotation(fn.ReturnType.Name, evaluated) {
+			return object.NewErrorFormat("TypeError: function expected to return `%s` got `%s` at %s", fn.ReturnType, typeOfResult(evaluated), fn.Body.Token)
+		}
+		return evaluated
 	case *object.Builtin:
 		return fn.Fn(args...)
+	case *object.EnumValue:
+		if !fn.IsConstructor() {
+			return object.NewErrorFormat("not a function: %s", fn.Inspect())
+		}
+		return fn.Construct(args...)
 	default:
 		return object.NewErrorFormat("not a function: %s", fn.Type())
 	}
@@ -90,3 +108,35 @@ func argumentsIsValid(parameters []object.Object, arguments []ast.Expression) er
 
 	return errors.New(fmt.Sprintf("Function expected %d arguments, got %d", len(arguments), len(parameters)))
 }
+
+// argumentsMatchTypes check if parameters passed to function match type
+// annotation declared on arguments
+func argumentsMatchTypes(parameters []object.Object, arguments []ast.Expression) error {
+	for i, arg := range arguments {
+		if i >= len(parameters) {
+			break
+		}
+
+		ident, ok := arg.(*ast.Identifier)
+		if infix, isInfix := arg.(*ast.InfixExpression); isInfix {
+			ident, ok = infix.Left.(*ast.Identifier)
+		}
+
+		if !ok || ident.Type == nil {
+			continue
+		}
+
+		if !object.MatchAnnotation(ident.Type.Name, parameters[i]) {
+			return fmt.Errorf("TypeError: function expected argument #%d (%s) to be `%s` got `%s`", i+1, ident.Value, ident.Type, typeOfResult(parameters[i]))
+		}
+	}
+	return nil
+}
+
+// typeOfResult get type of object, function without return produce nil
+func typeOfResult(obj object.Object) object.ObjectType {
+	if obj == nil {
+		return object.NULL_OBJ
+	}
+	return obj.Type()
+}
@@ -197,6 +197,18 @@ func TestErrorHashHandling(t *testing.T) {
 			"{} >= {}",
 			"unknown operator: HASH >= HASH",
 		},
+		{
+			"{[{}]: 1}",
+			"unusable as hash key: ARRAY",
+		},
+		{
+			"{1: 1}[{}]",
+			"unusable as hash key: HASH",
+		},
+		{
+			"var a = [1]; a.push(a); freeze(a); {a: 1}",
+			"unusable as hash key: ARRAY",
+		},
 	}
 
 	for _, tt := range tests {
@@ -373,6 +385,54 @@ func TestHashMethodWrongUsage(t *testing.T) {
 			`{}.type(1)`,
 			"TypeError: hash.type() takes exactly 0 argument (1 given)",
 		},
+		{
+			`{}.get()`,
+			"TypeError: hash.get() takes at least 1 arguments at most 2 (0 given)",
+		},
+		{
+			`{}.get({})`,
+			"TypeError: hash.get() unusable as hash key: HASH",
+		},
+		{
+			`{}.set("a")`,
+			"TypeError: hash.set() takes exactly 2 argument (1 given)",
+		},
+		{
+			`{}.merge([])`,
+			"TypeError: hash.merge() expected argument #1 to be `HASH` got `ARRAY`",
+		},
+		{
+			`{}.fromEntries([["a"]])`,
+			"TypeError: hash.fromEntries() expected entry #1 to be [key, value] got [a]",
+		},
+		{
+			`{"a": 1}.map(1)`,
+			"TypeError: hash.map() expected argument #1 to be `FUNCTION` got `INTEGER`",
+		},
+		{
+			`{"a": 1}.reduce(function(acc, v, k) { return acc; }, 0, 1)`,
+			"TypeError: hash.reduce() takes at least 1 arguments at most 2 (3 given)",
+		},
+		{
+			`{}.reduce(function(acc, v) { return acc + v; })`,
+			"TypeError: hash.reduce() of empty hash with no initial value",
+		},
+		{
+			`{"a": 1}.filter(function(v) { return v.foo(); })`,
+			"method foo not exists on integer object.",
+		},
+		{
+			`{"a": 1}.pick()`,
+			"TypeError: hash.pick() takes a minimum 1 arguments (0 given)",
+		},
+		{
+			`{"a": {}}.invert()`,
+			"TypeError: hash.invert() unusable as hash key: HASH",
+		},
+		{
+			`{}.ups()`,
+			"method ups not exists on hash object.",
+		},
 	}
 
 	for i, tt := range tests {
@@ -391,3 +451,117 @@ func TestHashMethodWrongUsage(t *testing.T) {
 
 	}
 }
+
+func TestHashRichMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"a": 1, "b": 2}.length()`, "2"},
+		{`{}.length()`, "0"},
+		{`{"a": 1}.get("a")`, "1"},
+		{`{"a": 1}.get("b")`, "null"},
+		{`{"a": 1}.get("b", 0)`, "0"},
+		{`var a = {"a": 1}; a.set("b", 2).set("a", 3); a`, "{a: 3, b: 2}"},
+		{`var a = {"a": 1, "b": 2}; a.remove("a")`, "1"},
+		{`var a = {"a": 1, "b": 2}; a.remove("c")`, "null"},
+		{`var a = {"a": 1, "b": 2}; a.remove("a"); a`, "{b: 2}"},
+		{`{"a": 1, "b": 2}.entries()`, "[[a, 1], [b, 2]]"},
+		{`{}.fromEntries([["a", 1], [[1, 2], true]])`, "{a: 1, [1, 2]: true}"},
+		{`{"a": 1}.fromEntries([["b", 2], ["a", 3]])`, "{a: 3, b: 2}"},
+		{`{"a": 1, "b": 2}.map(function(v, k) { return k + v.string(); })`, "{a: a1, b: b2}"},
+		{`{"a": 1, "b": 2}.map(function(v) { return v * 10; })`, "{a: 10, b: 20}"},
+		{`{"a": 1, "b": 2}.map(function() { return 0; })`, "{a: 0, b: 0}"},
+		{`{"a": 1, "b": 2}.filter(function(v, k) { return k == "a"; })`, "{a: 1}"},
+		{`{"a": 1, "b": 2}.filter(function(v) { return v > 1; })`, "{b: 2}"},
+		{`{"a": 1, "b": 2}.reduce(function(acc, v) { return acc + v; }, 0)`, "3"},
+		{`{"a": 1, "b": 2}.reduce(function(acc, v, k) { return acc + k; }, "")`, "ab"},
+		{`{"a": 1, "b": 2, "c": 3}.reduce(function(acc, v) { return acc + v; })`, "6"},
+		{`{"a": 1}.reduce(function(acc, v) { return acc + v; })`, "1"},
+		{`{}.reduce(function(acc, v) { return acc + v; }, 0)`, "0"},
+		{`{"a": "x", "b": "yz"}.map(len)`, "{a: 1, b: 2}"},
+		{`{"a": 1, "b": 2}.merge({"b": 3, "c": 4})`, "{a: 1, b: 3, c: 4}"},
+		{`var a = {"a": 1}; var b = {"b": 2}; a.merge(b); [a, b]`, "[{a: 1}, {b: 2}]"},
+		{`var a = freeze({"a": 1}); a.merge({"b": 2})`, "{a: 1, b: 2}"},
+		{`{"a": {"x": 1, "y": 2}, "b": 1}.deepMerge({"a": {"y": 3}, "b": {"z": 1}})`, "{a: {x: 1, y: 3}, b: {z: 1}}"},
+		{`var a = {"a": {"x": 1}}; a.deepMerge({"a": {"y": 2}}); a`, "{a: {x: 1}}"},
+		{`{"a": 1, "b": 2, "c": 3}.pick("a", "c", "d")`, "{a: 1, c: 3}"},
+		{`{"a": 1, "b": 2, "c": 3}.omit("a", "c")`, "{b: 2}"},
+		{`{"a": 1, "b": 2}.invert()`, "{1: a, 2: b}"},
+		{`var a = {"a": [1]}; var b = a.clone(); b["a"].push(2); [a, b]`, "[{a: [1]}, {a: [1, 2]}]"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashRichMethod[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated == nil {
+				t.Fatalf("expected %s. Got nil", tt.expected)
+			}
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %s. Got: %s", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestHashKeepInsertionOrder(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"b": 1, "a": 2, 3: 3}`, "{b: 1, a: 2, 3: 3}"},
+		{`{"b": 1, "a": 2}.keys()`, "[b, a]"},
+		{`{"b": 1, "a": 2}.values()`, "[1, 2]"},
+		{`var a = {"b": 1}; a["a"] = 2; a["b"] = 3; a;`, "{b: 3, a: 2}"},
+		{`var a = {"b": 1, "a": 2, "c": 3}; delete a["b"]; a["b"] = 4; a;`, "{a: 2, c: 3, b: 4}"},
+		{`{"a": 1, "a": 2, "b": 3}`, "{a: 2, b: 3}"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashKeepInsertionOrder[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("wrong order. got=%s, want=%s", evaluated.Inspect(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestHashCompositeKeys(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`{[1, 2]: "a"}[[1, 2]]`, "a"},
+		{`{[1, 2]: "a"}[[2, 1]]`, nil},
+		{`{[1, [2, "b"]]: "a"}[[1, [2, "b"]]]`, "a"},
+		{`var k = [1, 2]; var h = {k: "a"}; k[0] = 5; h[[1, 2]];`, "a"},
+		{`var k = [1, 2]; var h = {k: "a"}; k[0] = 5; h[k];`, nil},
+		{`var h = {}; h[[1]] = 2; h[[1]] = 3; h;`, "{[1]: 3}"},
+		{`var h = {[1]: 2, [3]: 4}; delete h[[1]]; h;`, "{[3]: 4}"},
+		{`{[1]: 2}.has([1])`, true},
+		{`{{1, 2}: "a"}[{2, 1}]`, "a"},
+		{`enum C { case RED; case BLUE; } {C::RED: 1}[C::RED]`, 1},
+		{`enum C { case RED; case BLUE; } {C::RED: 1}[C::BLUE]`, nil},
+		{`enum S { case Circle(r); } {S::Circle(1): "c"}[S::Circle(1)]`, "c"},
+		{`enum S { case Circle(r); } {S::Circle(1): "c"}[S::Circle(2)]`, nil},
+		{`{0.1234567: 1}[0.1234568]`, nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashCompositeKeys[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if hash, ok := evaluated.(*object.Hash); ok {
+				if hash.Inspect() != tt.expected {
+					t.Errorf("wrong hash. got=%s, want=%s", hash.Inspect(), tt.expected)
+				}
+				return
+			}
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gravataLonga/ninja/ast"
 	"github.com/gravataLonga/ninja/object"
 	"github.com/gravataLonga/ninja/stdlib"
+	"path/filepath"
 )
 
 func evalIdentifier(
@@ -15,6 +16,13 @@ func evalIdentifier(
 		return val
 	}
 
+	switch node.Value {
+	case "__FILE__":
+		return &object.String{Value: currentFile(env)}
+	case "__DIR__":
+		return &object.String{Value: currentDir(env)}
+	}
+
 	if builtin, ok := stdlib.Builtins[node.Value]; ok {
 		return builtin
 	}
@@ -26,6 +34,31 @@ func evalIdentifier(
 	return object.NewErrorFormat("identifier not found: %s %s", node.Value, node.Token)
 }
 
+// currentFile get absolute path of file being evaluated, it is empty when code
+// doesn't come from a file, e.g.: repl.
+func currentFile(env *object.Environment) string {
+	if env.File() == "" {
+		return ""
+	}
+
+	file, err := filepath.Abs(env.File())
+	if err != nil {
+		return env.File()
+	}
+	return file
+}
+
+// currentDir get directory of file being evaluated, when code doesn't come from
+// a file it is current working directory
+func currentDir(env *object.Environment) string {
+	if file := currentFile(env); file != "" {
+		return filepath.Dir(file)
+	}
+
+	dir, _ := filepath.Abs(".")
+	return dir
+}
+
 func evalAssignStatement(node *ast.AssignStatement, env *object.Environment) object.Object {
 
 	switch node.Name.(type) {
@@ -54,6 +87,10 @@ func evalAssignIdentifier(node *ast.AssignStatement, env *object.Environment) ob
 		return object.NewErrorFormat("identifier not found: %s %s", identifier.Value, node.Token)
 	}
 
+	if env.IsConstant(identifier.Value) {
+		return object.NewErrorFormat("cannot reassign constant %s %s", identifier.Value, node.Token)
+	}
+
 	val := Eval(node.Value, env)
 	if object.IsError(val) {
 		return val
@@ -78,15 +115,21 @@ func evalAssignIndexIdentifier(node *ast.AssignStatement, env *object.Environmen
 	switch objIdentifier.(type) {
 	case *object.Hash:
 		hashObject, _ := objIdentifier.(*object.Hash)
+		if hashObject.IsFrozen() {
+			return object.NewErrorFormat("TypeError: cannot modify frozen hash")
+		}
 
 		objIndex := Eval(indexIdentifier.Index, env)
-		h, ok := objIndex.(object.Hashable)
+		h, ok := object.HashKeyOf(objIndex)
 		if !ok {
 			return object.NewErrorFormat("expected index to be hashable")
 		}
-		hashObject.Pairs[h.HashKey()] = object.HashPair{Key: objIndex, Value: value}
+		hashObject.Set(h, object.HashPair{Key: objIndex, Value: value})
 	case *object.Array:
 		arrayObject, _ := objIdentifier.(*object.Array)
+		if arrayObject.IsFrozen() {
+			return object.NewErrorFormat("TypeError: cannot modify frozen array")
+		}
 
 		objIndex := Eval(indexIdentifier.Index, env)
 		objectIndexInteger, ok := objIndex.(*object.Integer)
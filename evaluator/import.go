@@ -5,6 +5,7 @@ import (
 	"github.com/gravataLonga/ninja/lexer"
 	"github.com/gravataLonga/ninja/object"
 	"github.com/gravataLonga/ninja/parser"
+	"github.com/gravataLonga/ninja/semantic"
 	"os"
 	"strings"
 )
@@ -22,33 +23,114 @@ func evalImport(node ast.Node, env *object.Environment) object.Object {
 		return object.NULL
 	}
 
-	readFile, err := os.Open(filename.Value)
+	importer := env.File()
+	path := resolveImport(filename.Value, importer)
+
+	// file which start program is part of import chain too
+	if importer != "" && len(modules.loading) == 0 {
+		modules.push(canonicalPath(importer), importer)
+		defer modules.pop()
+	}
+
+	module, result := loadModule(path, astImport)
+	if object.IsError(result) {
+		return result
+	}
+
+	switch {
+	case astImport.Alias != nil:
+		if err := bindImport(astImport.Alias, module, env); object.IsError(err) {
+			return err
+		}
+		return nil
+	case astImport.Names != nil:
+		for _, name := range astImport.Names {
+			value, ok := module.Lookup(name.Value)
+			if !ok {
+				return object.NewErrorFormat("module '%s' does not export %s %s", module.Name, name.Value, name.Token)
+			}
+
+			if err := bindImport(name, value, env); object.IsError(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return result
+}
+
+// bindImport set imported value on importer environment
+func bindImport(name *ast.Identifier, value object.Object, env *object.Environment) object.Object {
+	if env.IsLocalConstant(name.Value) {
+		return object.NewErrorFormat("cannot redeclare constant %s %s", name.Value, name.Token)
+	}
+	return env.Set(name.Value, value)
+}
+
+// loadModule evaluate file on it is own environment, it returns module with
+// exported values and value returned by last statement of file, if any.
+// Modules are evaluated once, next imports get cached module.
+func loadModule(filename string, astImport *ast.Import) (*object.Module, object.Object) {
+	canonical := canonicalPath(filename)
+	if chain, ok := modules.cycle(canonical, filename); ok {
+		return nil, object.NewErrorFormat("import cycle detected: %s %s", formatImportChain(chain), astImport.Token)
+	}
+
+	if loaded, ok := modules.get(canonical); ok {
+		return loaded.module, loaded.result
+	}
+
+	modules.push(canonical, filename)
+	defer modules.pop()
+
+	module, result := evalModule(filename, astImport)
+	if !object.IsError(result) {
+		modules.store(canonical, module, result)
+	}
+	return module, result
+}
+
+func evalModule(filename string, astImport *ast.Import) (*object.Module, object.Object) {
+	readFile, err := os.Open(filename)
 
 	if err != nil {
-		return object.NewErrorFormat("IO Error: error reading file '%s': %s %s", filename.Value, err, astImport.Token)
+		return nil, object.NewErrorFormat("IO Error: error reading file '%s': %s %s", filename, err, astImport.Token)
 	}
+	defer readFile.Close()
 
 	l := lexer.New(readFile)
 	p := parser.New(l)
 	programs := p.ParseProgram()
 
 	if len(p.Errors()) > 0 {
-		strErros := []string{}
-		for _, e := range p.Errors() {
-			strErros = append(strErros, e)
-		}
-		return object.NewErrorFormat("%s: %s", filename.Value, strings.Join(strErros, "\n"))
+		return nil, object.NewErrorFormat("%s: %s", filename, strings.Join(p.Errors(), "\n"))
 	}
 
-	result := Eval(programs, env)
+	macroEnv := object.NewEnvironment()
+	DefineMacros(programs, macroEnv)
+	expanded, macroErrors := ExpandMacros(programs, macroEnv)
+	if len(macroErrors) > 0 {
+		return nil, object.NewErrorFormat("%s: %s", filename, strings.Join(macroErrors, "\n"))
+	}
 
-	if result == nil {
-		return nil
+	s := semantic.New(expanded)
+	s.Analysis()
+	if len(s.Errors()) > 0 {
+		return nil, object.NewErrorFormat("%s: %s", filename, strings.Join(s.Errors(), "\n"))
 	}
 
-	errorStr, ok := result.(*object.Error)
-	if ok {
-		return object.NewErrorFormat("%s: %s", filename.Value, errorStr.Message)
+	moduleEnv := object.NewEnvironment()
+	moduleEnv.SetFile(filename)
+	result := Eval(expanded, moduleEnv)
+
+	if errorStr, ok := result.(*object.Error); ok {
+		return nil, object.NewErrorFormat("%s: %s", filename, errorStr.Message)
+	}
+
+	module := exportedValues(programs, moduleEnv, filename)
+	if result == nil {
+		return module, nil
 	}
 
 	// Only return if last item of imported file have "return"
@@ -56,11 +138,27 @@ func evalImport(node ast.Node, env *object.Environment) object.Object {
 		stmts := programs.Statements
 		stmt := stmts[len(stmts)-1]
 
-		_, ok = stmt.(*ast.ReturnStatement)
-		if ok {
-			return result
+		if _, ok := stmt.(*ast.ReturnStatement); ok {
+			return module, result
 		}
 	}
 
-	return object.NULL
+	return module, object.NULL
+}
+
+// exportedValues create module with values of exported declarations
+func exportedValues(program *ast.Program, env *object.Environment, filename string) *object.Module {
+	module := object.NewModule(filename)
+	for _, stmt := range program.Statements {
+		export, ok := stmt.(*ast.ExportStatement)
+		if !ok {
+			continue
+		}
+
+		name := export.Name()
+		if value, ok := env.Get(name.Value); ok {
+			module.Export(name.Value, value)
+		}
+	}
+	return module
 }
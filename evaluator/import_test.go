@@ -11,7 +11,7 @@ func TestImportStatements(t *testing.T) {
 		input    string
 		expected interface{}
 	}{
-		{`import "../fixtures/stub.nj"; add(1, 1);`, 2},
+		{`import {add} from "../fixtures/stub.nj"; add(1, 1);`, 2},
 		{`var a = import "../fixtures/stub.nj"; a;`, nil},
 		{`var a = import "../fixtures/stub_return.nj"; a;`, 2},
 	}
@@ -22,6 +22,72 @@ func TestImportStatements(t *testing.T) {
 	}
 }
 
+func TestImportModule(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`import "../fixtures/module.nj" as lib; lib.add(1, 2);`, 3},
+		{`import "../fixtures/module.nj" as lib; lib.PI;`, 3.14},
+		{`import "../fixtures/module.nj" as lib; lib.greeting;`, "hello hidden"},
+		{`import "../fixtures/module.nj" as lib; lib.Color::GREEN.ordinal();`, 1},
+		{`import "../fixtures/module.nj" as lib; lib.exports();`, object.Array{Elements: []object.Object{&object.String{Value: "PI"}, &object.String{Value: "add"}, &object.String{Value: "greeting"}, &object.String{Value: "Color"}}}},
+		{`import {add, PI} from "../fixtures/module.nj"; add(PI, 1);`, 4.14},
+		{`import {Color} from "../fixtures/module.nj"; Color::RED < Color::GREEN;`, true},
+		{`function helper() { return "mine"; }; import "../fixtures/module.nj" as lib; helper();`, "mine"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestImportModule[%d]", i), func(t *testing.T) {
+			v := testEval(tt.input, t)
+			testObjectLiteral(t, v, tt.expected)
+		})
+	}
+}
+
+func TestImportModuleErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{
+			`import "../fixtures/module.nj"; helper();`,
+			"identifier not found: helper IDENT at [Line: 1, Offset: 39]",
+		},
+		{
+			`import "../fixtures/module.nj" as lib; lib.helper();`,
+			"method helper not exists on module ../fixtures/module.nj.",
+		},
+		{
+			`import "../fixtures/module.nj" as lib; lib.nothing;`,
+			"module '../fixtures/module.nj' does not export nothing IDENT at [Line: 1, Offset: 51]",
+		},
+		{
+			`import {add, helper} from "../fixtures/module.nj";`,
+			"module '../fixtures/module.nj' does not export helper IDENT at [Line: 1, Offset: 20]",
+		},
+		{
+			`const add = 1; import {add} from "../fixtures/module.nj";`,
+			"cannot redeclare constant add IDENT at [Line: 1, Offset: 27]",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestImportModuleErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedMessage, errObj.Message)
+			}
+		})
+	}
+}
+
 func TestErrorImportHandling(t *testing.T) {
 	tests := []struct {
 		input           string
@@ -39,6 +105,10 @@ func TestErrorImportHandling(t *testing.T) {
 			`import "../fixtures/stub-with-error-in-function.nj"`,
 			"../fixtures/stub-with-error-in-function.nj: Function expected 2 arguments, got 3 at { at [Line: 16, Offset: 38]",
 		},
+		{
+			`import "../fixtures/stub-with-const-reassign.nj"`,
+			"../fixtures/stub-with-const-reassign.nj: cannot reassign constant limit IDENT at [Line: 2, Offset: 6]",
+		},
 	}
 
 	for i, tt := range tests {
@@ -15,6 +15,10 @@ func evalInfixExpression(
 		return evalStringInfixExpression(operator, left, right)
 	case object.IsNumber(left) && object.IsNumber(right):
 		return evalFloatOrIntegerInfixExpression(operator, left, right)
+	case object.IsSet(left) && object.IsSet(right):
+		return evalSetInfixExpression(operator, left, right)
+	case left.Type() == object.ENUM_VALUE_OBJ && right.Type() == object.ENUM_VALUE_OBJ:
+		return evalEnumInfixExpression(operator, left, right)
 	case operator == "==":
 		// todo in future we can compare each array
 		if object.IsArray(left) || object.IsArray(right) {
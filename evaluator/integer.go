@@ -1,8 +1,8 @@
 package evaluator
 
 import (
-	"math"
 	"github.com/gravataLonga/ninja/object"
+	"math"
 )
 
 func evalIntegerInfixExpression(
@@ -23,31 +23,58 @@ func evalIntegerInfixExpression(
 		return &object.Integer{Value: leftVal | rightVal}
 	case "^":
 		return &object.Integer{Value: leftVal ^ rightVal}
-	case "<<":
-		return &object.Integer{Value: leftVal << rightVal}
-	case ">>":
-		return &object.Integer{Value: leftVal >> rightVal}
+	case "<<", ">>":
+		if rightVal < 0 {
+			return object.NewErrorFormat("negative shift count: %d %s %d", leftVal, operator, rightVal)
+		}
+
+		if operator == ">>" {
+			return &object.Integer{Value: leftVal >> rightVal}
+		}
+		return evalBigIntInfixExpression(operator, left, right)
 	case "+":
-		return &object.Integer{Value: leftVal + rightVal}
+		sum := leftVal + rightVal
+		if (sum > leftVal) != (rightVal > 0) {
+			return evalBigIntInfixExpression(operator, left, right)
+		}
+		return &object.Integer{Value: sum}
 	case "-":
-		return &object.Integer{Value: leftVal - rightVal}
+		diff := leftVal - rightVal
+		if (diff < leftVal) != (rightVal > 0) {
+			return evalBigIntInfixExpression(operator, left, right)
+		}
+		return &object.Integer{Value: diff}
 	case "*":
-		return &object.Integer{Value: leftVal * rightVal}
+		if leftVal == 0 || rightVal == 0 {
+			return &object.Integer{Value: 0}
+		}
+
+		product := leftVal * rightVal
+		if product/rightVal != leftVal || (leftVal == -1 && rightVal == math.MinInt64) || (rightVal == -1 && leftVal == math.MinInt64) {
+			return evalBigIntInfixExpression(operator, left, right)
+		}
+		return &object.Integer{Value: product}
 	case "%":
+		if rightVal == 0 {
+			return object.NewErrorFormat("division by zero: %d %% %d", leftVal, rightVal)
+		}
 		return &object.Integer{Value: leftVal % rightVal}
 	case "**":
+		if rightVal >= 0 {
+			return evalBigIntInfixExpression(operator, left, right)
+		}
 		return &object.Integer{Value: int64(math.Pow(float64(leftVal), float64(rightVal)))}
 	case "/":
-		left := float64(leftVal)
-		right := float64(rightVal)
-		total := left / right
-
-		// @todo check if we need a ELIPSON var or if there any way of doing
-		if math.Round(total)-total <= 0.00000000000001 {
-			return &object.Integer{Value: int64(total)}
+		if rightVal == 0 {
+			return &object.Float{Value: float64(leftVal) / 0}
 		}
 
-		return &object.Float{Value: left / right}
+		// MinInt64 / -1 overflow and inexact quotients need exact math to become
+		// a float, so both are divided as big integers
+		if leftVal%rightVal != 0 || (leftVal == math.MinInt64 && rightVal == -1) {
+			return evalBigIntInfixExpression(operator, left, right)
+		}
+		return &object.Integer{Value: leftVal / rightVal}
 	case "<":
 		return nativeBoolToBooleanObject(leftObject.Compare(rightObject) == -1)
 	case ">":
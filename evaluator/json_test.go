@@ -0,0 +1,120 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestJSONModule(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`json.encode({"a": [1, 2.5, "x"], "b": true, "c": first([])})`, `{"a":[1,2.5,"x"],"b":true,"c":null}`},
+		{`json.encode([1, {"a": 1}], {"indent": 2})`, "[\n  1,\n  {\n    \"a\": 1\n  }\n]"},
+		{`json.encode([1], {"indent": "\t"})`, "[\n\t1\n]"},
+		{`json.encode("<b>")`, `"<b>"`},
+		{`json.encode({1: {2, 3}})`, `{"1":[2,3]}`},
+		{`json.decode("{\"b\": [1, 2.5], \"a\": null}")`, "{b: [1, 2.500000], a: null}"},
+		{`json.decode("[1, 2]")[1] + 1`, "3"},
+		{`json.decode(json.encode({"a": [1, 2.0, "é"]}))`, "{a: [1, 2.000000, é]}"},
+		{`json.decoder("{\"a\": 1}\n{\"a\": 2}\n").toArray()`, "[{a: 1}, {a: 2}]"},
+		{`var it = json.decoder("[1, [2], 3]", {"elements": true}); [it.next(), it.next(), it.next(), it.next()]`, "[1, [2], 3, null]"},
+		{`json.type()`, "MODULE"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestJSONModule[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestJSONModuleFromStandardInput(t *testing.T) {
+	original := object.StandardInput
+	defer func() { object.StandardInput = original }()
+
+	object.StandardInput = strings.NewReader(`[{"id": 1}, {"id": 2}]`)
+	evaluated := testEval(`var it = json.decoder({"elements": true}); [it.next()["id"], it.next()["id"], it.next()]`, t)
+
+	if evaluated.Inspect() != "[1, 2, null]" {
+		t.Errorf("expected [1, 2, null]. Got: %s", evaluated.Inspect())
+	}
+}
+
+func TestJSONModuleFromFile(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	os.WriteFile(valid, []byte(`[{"id": 1}, {"id": 2}, {"id": 3}]`), 0644)
+
+	// tail of file is broken, first elements are still given since file is decoded as it is read
+	broken := filepath.Join(dir, "broken.json")
+	os.WriteFile(broken, []byte(`[{"id": 1}, {"id": `), 0644)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{fmt.Sprintf(`json.decoder({"file": %q, "elements": true}).toArray().map(function(v) { return v["id"]; })`, valid), "[1, 2, 3]"},
+		{fmt.Sprintf(`json.decoder({"file": %q}).next().length()`, valid), "3"},
+		{fmt.Sprintf(`json.decoder({"file": %q, "elements": true}).next()`, broken), "{id: 1}"},
+		{fmt.Sprintf(`var it = json.decoder({"file": %q, "elements": true}); it.next(); it.next()`, broken), "ERROR: SyntaxError: json.decoder() unexpected end of input at line 1, column 20"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestJSONModuleFromFile[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestJSONModuleWrongUsage(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`json.encode()`, "TypeError: json.encode() takes at least 1 arguments at most 2 (0 given)"},
+		{`json.encode(function() {})`, "TypeError: json.encode() unable to encode `FUNCTION` to json"},
+		{`json.encode(1, [])`, "TypeError: json.encode() expected options to be `HASH` got `ARRAY`"},
+		{`json.encode(1, {"spaces": 2})`, "ValueError: json.encode() unknown option spaces, expected one of indent"},
+		{`json.encode(1, {"indent": -1})`, "ValueError: json.encode() indent must not be negative got -1"},
+		{`json.encode(1, {"indent": true})`, "TypeError: json.encode() option indent expected to be `INTEGER` or `STRING` got `BOOLEAN`"},
+		{`json.decode(1)`, "TypeError: json.decode() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`json.decode("{\"a\": 1,\n \"b\" 2}")`, "SyntaxError: json.decode() invalid character '2' after object key at line 2, column 6"},
+		{`json.decode("[1, 2")`, "SyntaxError: json.decode() unexpected end of input at line 1, column 6"},
+		{`json.decoder(1)`, "TypeError: json.decoder() expected options to be `HASH` got `INTEGER`"},
+		{`json.decoder("1", {"elements": true}).next()`, "SyntaxError: json.decoder() expected an array at line 1, column 1"},
+		{`json.decoder("[1 2]", {"elements": true}).toArray()`, "SyntaxError: json.decoder() invalid character '2' after array element at line 1, column 4"},
+		{`json.decoder({"file": 1})`, "TypeError: json.decoder() option file expected to be `STRING` got `INTEGER`"},
+		{`json.decoder("[]", {"file": "a.json"})`, "ValueError: json.decoder() can't decode from string and file at same time"},
+		{`json.decoder({"file": "/ninja/missing.json"})`, "IOError: json.decoder() open /ninja/missing.json: no such file or directory"},
+		{`json.parse("1")`, "method parse not exists on module json."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestJSONModuleWrongUsage[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
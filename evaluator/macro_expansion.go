@@ -0,0 +1,201 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/object"
+	"sync/atomic"
+)
+
+// gensym is a counter used to give unique names to identifiers declared by macros
+var gensym uint64
+
+// DefineMacros register macros declared at top level of program on env and
+// remove their definitions from program.
+func DefineMacros(program *ast.Program, env *object.Environment) {
+	statements := make([]ast.Statement, 0, len(program.Statements))
+
+	for _, statement := range program.Statements {
+		name, macro, ok := macroDefinition(statement)
+		if !ok {
+			statements = append(statements, statement)
+			continue
+		}
+
+		env.Set(name.Value, &object.Macro{Parameters: macro.Parameters, Body: macro.Body, Env: env})
+	}
+
+	program.Statements = statements
+}
+
+func macroDefinition(node ast.Statement) (*ast.Identifier, *ast.MacroLiteral, bool) {
+	var name *ast.Identifier
+	var value ast.Expression
+
+	switch node := node.(type) {
+	case *ast.VarStatement:
+		name, value = node.Name, node.Value
+	case *ast.ConstStatement:
+		name, value = node.Name, node.Value
+	default:
+		return nil, nil, false
+	}
+
+	macro, ok := value.(*ast.MacroLiteral)
+	return name, macro, ok
+}
+
+// ExpandMacros replace each macro call by code it produce, arguments are passed
+// to macro as quote, without being evaluated.
+func ExpandMacros(program ast.Node, env *object.Environment) (ast.Node, []string) {
+	var errors []string
+
+	expanded := ast.Modify(program, func(node ast.Node) ast.Node {
+		call, ok := node.(*ast.CallExpression)
+		if !ok {
+			return node
+		}
+
+		macro, ok := isMacroCall(call, env)
+		if !ok {
+			return node
+		}
+
+		if len(call.Arguments) != len(macro.Parameters) {
+			errors = append(errors, fmt.Sprintf("macro expected %d arguments, got %d %s", len(macro.Parameters), len(call.Arguments), call.Token))
+			return node
+		}
+
+		evalEnv := extendMacroEnv(macro, quoteArgs(call))
+		evaluated := unwrapReturnValue(Eval(hygienic(macro.Body), evalEnv))
+
+		quote, ok := evaluated.(*object.Quote)
+		if !ok {
+			if object.IsError(evaluated) {
+				errors = append(errors, fmt.Sprintf("%s %s", evaluated.(*object.Error).Message, call.Token))
+				return node
+			}
+			errors = append(errors, fmt.Sprintf("macro must return a quote, got %s %s", typeOfResult(evaluated), call.Token))
+			return node
+		}
+
+		return quote.Node
+	})
+
+	return expanded, errors
+}
+
+func isMacroCall(call *ast.CallExpression, env *object.Environment) (*object.Macro, bool) {
+	ident, ok := call.Function.(*ast.Identifier)
+	if !ok {
+		return nil, false
+	}
+
+	obj, ok := env.Get(ident.Value)
+	if !ok {
+		return nil, false
+	}
+
+	macro, ok := obj.(*object.Macro)
+	return macro, ok
+}
+
+func quoteArgs(call *ast.CallExpression) []*object.Quote {
+	args := make([]*object.Quote, len(call.Arguments))
+	for i, a := range call.Arguments {
+		args[i] = &object.Quote{Node: a}
+	}
+	return args
+}
+
+func extendMacroEnv(macro *object.Macro, args []*object.Quote) *object.Environment {
+	extended := object.NewEnclosedEnvironment(macro.Env)
+	for i, param := range macro.Parameters {
+		extended.Set(param.Value, args[i])
+	}
+	return extended
+}
+
+// hygienic rename identifiers declared inside quote() of macro body, so code
+// produced by macro never capture or shadow identifiers where it is expanded.
+// Identifiers inside unquote() are left untouched, they belong to macro itself.
+func hygienic(body *ast.BlockStatement) *ast.BlockStatement {
+	declared := map[string]string{}
+	quoted := map[*ast.Identifier]bool{}
+
+	ast.Walk(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpression)
+		if !ok || !isCallTo(call, "quote") {
+			return true
+		}
+
+		for _, arg := range call.Arguments {
+			collectQuotedIdentifiers(arg, declared, quoted)
+		}
+		return false
+	})
+
+	if len(declared) == 0 {
+		return body
+	}
+
+	for name := range declared {
+		declared[name] = fmt.Sprintf("%s@%d", name, atomic.AddUint64(&gensym, 1))
+	}
+
+	renamed, _ := ast.Modify(body, func(node ast.Node) ast.Node {
+		ident, ok := node.(*ast.Identifier)
+		if !ok || !quoted[ident] {
+			return node
+		}
+
+		name, ok := declared[ident.Value]
+		if !ok {
+			return node
+		}
+
+		copied := *ident
+		copied.Value = name
+		return &copied
+	}).(*ast.BlockStatement)
+
+	return renamed
+}
+
+func collectQuotedIdentifiers(node ast.Node, declared map[string]string, quoted map[*ast.Identifier]bool) {
+	ast.Walk(node, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpression:
+			return !isCallTo(node, "unquote")
+		case *ast.Identifier:
+			quoted[node] = true
+		case *ast.VarStatement:
+			declared[node.Name.Value] = ""
+		case *ast.ConstStatement:
+			declared[node.Name.Value] = ""
+		case *ast.FunctionLiteral:
+			if node.Name != nil {
+				declared[node.Name.Value] = ""
+			}
+			for _, param := range node.Parameters {
+				if ident := parameterName(param); ident != nil {
+					declared[ident.Value] = ""
+				}
+			}
+		}
+		return true
+	})
+}
+
+// parameterName get identifier of parameter, parameters with default value
+// are infix expressions.
+func parameterName(param ast.Expression) *ast.Identifier {
+	switch param := param.(type) {
+	case *ast.Identifier:
+		return param
+	case *ast.InfixExpression:
+		ident, _ := param.Left.(*ast.Identifier)
+		return ident
+	}
+	return nil
+}
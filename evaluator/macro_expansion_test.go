@@ -0,0 +1,238 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"github.com/gravataLonga/ninja/object"
+	"github.com/gravataLonga/ninja/parser"
+	"strings"
+	"testing"
+)
+
+func TestDefineMacros(t *testing.T) {
+	input := `
+	var number = 1;
+	var fn = function(x, y) { x + y };
+	var mymacro = macro(x, y) { x + y; };
+	const other = macro() { quote(1); };
+	`
+
+	env := object.NewEnvironment()
+	program := testParseProgram(t, input)
+
+	DefineMacros(program, env)
+
+	if len(program.Statements) != 2 {
+		t.Fatalf("Wrong number of statements. got=%d", len(program.Statements))
+	}
+
+	_, ok := env.Get("number")
+	if ok {
+		t.Fatalf("number should not be defined")
+	}
+	_, ok = env.Get("fn")
+	if ok {
+		t.Fatalf("fn should not be defined")
+	}
+
+	obj, ok := env.Get("mymacro")
+	if !ok {
+		t.Fatalf("macro not in environment.")
+	}
+
+	macro, ok := obj.(*object.Macro)
+	if !ok {
+		t.Fatalf("object is not Macro. got=%T (%+v)", obj, obj)
+	}
+
+	if len(macro.Parameters) != 2 {
+		t.Fatalf("Wrong number of macro parameters. got=%d", len(macro.Parameters))
+	}
+
+	if macro.Parameters[0].String() != "x" {
+		t.Fatalf("parameter is not 'x'. got=%q", macro.Parameters[0])
+	}
+	if macro.Parameters[1].String() != "y" {
+		t.Fatalf("parameter is not 'y'. got=%q", macro.Parameters[1])
+	}
+
+	expectedBody := "(x + y)"
+
+	if macro.Body.String() != expectedBody {
+		t.Fatalf("body is not %q. got=%q", expectedBody, macro.Body.String())
+	}
+
+	if _, ok := env.Get("other"); !ok {
+		t.Fatalf("const macro not in environment.")
+	}
+}
+
+func TestExpandMacros(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			`
+			var infixExpression = macro() { quote(1 + 2); };
+
+			infixExpression();
+			`,
+			`(1 + 2)`,
+		},
+		{
+			`
+			var reverse = macro(a, b) { quote(unquote(b) - unquote(a)); };
+
+			reverse(2 + 2, 10 - 5);
+			`,
+			`(10 - 5) - (2 + 2)`,
+		},
+		{
+			`
+			var unless = macro(condition, consequence, alternative) {
+				quote(if (!(unquote(condition))) {
+					unquote(consequence);
+				} else {
+					unquote(alternative);
+				});
+			};
+
+			unless(10 > 5, puts("not greater"), puts("greater"));
+			`,
+			`if (!(10 > 5)) { puts("not greater") } else { puts("greater") }`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestExpandMacros[%d]", i), func(t *testing.T) {
+			expected := testParseProgram(t, tt.expected)
+			program := testParseProgram(t, tt.input)
+
+			env := object.NewEnvironment()
+			DefineMacros(program, env)
+			expanded, errors := ExpandMacros(program, env)
+
+			if len(errors) != 0 {
+				t.Fatalf("unexpected macro errors: %v", errors)
+			}
+
+			if expanded.String() != expected.String() {
+				t.Errorf("not equal. want=%q, got=%q", expected.String(), expanded.String())
+			}
+		})
+	}
+}
+
+func TestExpandMacrosHygiene(t *testing.T) {
+	input := `
+	var swap = macro(a, b) {
+		quote(function () {
+			var tmp = unquote(a);
+			return [unquote(b), tmp];
+		}());
+	};
+
+	var tmp = 1;
+	var other = 2;
+	var result = swap(other, tmp);
+	result[0] + result[1] * 10 + tmp * 100;
+	`
+
+	evaluated := testEvalMacros(t, input)
+	testIntegerObject(t, evaluated, 121)
+}
+
+func TestExpandMacrosRenameOnEachExpansion(t *testing.T) {
+	input := `
+	var swap = macro(a, b) {
+		quote(function () {
+			var tmp = unquote(a);
+			return [unquote(b), tmp];
+		}());
+	};
+
+	var tmp = 1;
+	var first = swap(tmp, 2);
+	var second = swap(3, tmp);
+	first[0] * 1000 + first[1] * 100 + second[0] * 10 + second[1];
+	`
+
+	evaluated := testEvalMacros(t, input)
+	testIntegerObject(t, evaluated, 2113)
+}
+
+func TestExpandMacrosEvaluateArgumentsLazily(t *testing.T) {
+	input := `
+	var unless = macro(condition, consequence, alternative) {
+		quote(if (!(unquote(condition))) {
+			unquote(consequence);
+		} else {
+			unquote(alternative);
+		});
+	};
+
+	var calls = [0];
+	unless(10 > 5, function() { calls[0] = calls[0] + 1; }(), function() { calls[0] = calls[0] + 10; }());
+	calls[0];
+	`
+
+	evaluated := testEvalMacros(t, input)
+	testIntegerObject(t, evaluated, 10)
+}
+
+func TestExpandMacrosErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{
+			`var m = macro(a) { quote(unquote(a)); }; m(1, 2);`,
+			"macro expected 1 arguments, got 2 ( at [Line: 1, Offset: 43]",
+		},
+		{
+			`var m = macro() { 1; }; m();`,
+			"macro must return a quote, got INTEGER ( at [Line: 1, Offset: 26]",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestExpandMacrosErrors[%d]", i), func(t *testing.T) {
+			program := testParseProgram(t, tt.input)
+
+			env := object.NewEnvironment()
+			DefineMacros(program, env)
+			_, errors := ExpandMacros(program, env)
+
+			if len(errors) != 1 {
+				t.Fatalf("expected 1 macro error. Got: %v", errors)
+			}
+
+			if errors[0] != tt.expectedError {
+				t.Errorf("expected error %q. Got: %q", tt.expectedError, errors[0])
+			}
+		})
+	}
+}
+
+func testParseProgram(t *testing.T, input string) *ast.Program {
+	l := lexer.New(strings.NewReader(input))
+	p := parser.New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+	return program
+}
+
+func testEvalMacros(t *testing.T, input string) object.Object {
+	program := testParseProgram(t, input)
+
+	macros := object.NewEnvironment()
+	DefineMacros(program, macros)
+	expanded, errors := ExpandMacros(program, macros)
+	if len(errors) != 0 {
+		t.Fatalf("unexpected macro errors: %v", errors)
+	}
+
+	return Eval(expanded, object.NewEnvironment())
+}
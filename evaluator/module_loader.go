@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"github.com/gravataLonga/ninja/object"
+	"github.com/gravataLonga/ninja/packages"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// NinjaPathEnv is environment variable with a list of directories where
+// imports are searched when they aren't found relative to importing file.
+const NinjaPathEnv = "NINJA_PATH"
+
+// loadedModule is what we keep from an evaluated module
+type loadedModule struct {
+	module *object.Module
+	result object.Object
+}
+
+// moduleLoader cache modules by canonical path and keep track of modules being
+// loaded, so circular imports can be detected.
+type moduleLoader struct {
+	cache   map[string]*loadedModule
+	loading []string // canonical path of modules being loaded, by import order
+	chain   []string // path of modules being loaded, as they were resolved
+}
+
+var modules = newModuleLoader()
+
+func newModuleLoader() *moduleLoader {
+	return &moduleLoader{cache: map[string]*loadedModule{}}
+}
+
+// resolveImport find file imported by name, it is resolved relative to importing
+// file, or working directory when there isn't one, then as a package vendored
+// on project and last on each directory of NINJA_PATH. When file isn't found
+// anywhere, first candidate is returned.
+func resolveImport(name string, importer string) string {
+	if filepath.IsAbs(name) {
+		return filepath.Clean(name)
+	}
+
+	dir := "."
+	if importer != "" {
+		dir = filepath.Dir(importer)
+	}
+
+	candidate := filepath.Join(dir, name)
+	if fileExists(candidate) {
+		return candidate
+	}
+
+	if path, ok := packages.Resolve(name, dir); ok {
+		return path
+	}
+
+	for _, dir := range filepath.SplitList(os.Getenv(NinjaPathEnv)) {
+		if dir == "" {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		if fileExists(path) {
+			return path
+		}
+	}
+
+	return candidate
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// canonicalPath get absolute path without symbolic links, so same file is
+// always cached once.
+func canonicalPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	real, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return abs
+	}
+	return real
+}
+
+// cycle check if module is already being loaded, it returns chain of imports
+// which lead to it again.
+func (ml *moduleLoader) cycle(canonical string, path string) ([]string, bool) {
+	for i, loading := range ml.loading {
+		if loading != canonical {
+			continue
+		}
+
+		chain := make([]string, 0, len(ml.chain)-i+1)
+		chain = append(chain, ml.chain[i:]...)
+		return append(chain, path), true
+	}
+	return nil, false
+}
+
+func (ml *moduleLoader) push(canonical string, path string) {
+	ml.loading = append(ml.loading, canonical)
+	ml.chain = append(ml.chain, path)
+}
+
+func (ml *moduleLoader) pop() {
+	ml.loading = ml.loading[:len(ml.loading)-1]
+	ml.chain = ml.chain[:len(ml.chain)-1]
+}
+
+func (ml *moduleLoader) get(canonical string) (*loadedModule, bool) {
+	loaded, ok := ml.cache[canonical]
+	return loaded, ok
+}
+
+func (ml *moduleLoader) store(canonical string, module *object.Module, result object.Object) {
+	ml.cache[canonical] = &loadedModule{module: module, result: result}
+}
+
+func formatImportChain(chain []string) string {
+	return strings.Join(chain, " -> ")
+}
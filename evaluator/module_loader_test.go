@@ -0,0 +1,137 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveImport(t *testing.T) {
+	t.Setenv(NinjaPathEnv, "../fixtures/none"+string(filepath.ListSeparator)+"../fixtures/modules/vendor")
+
+	tests := []struct {
+		name     string
+		importer string
+		expected string
+	}{
+		{"../fixtures/modules/base.nj", "", "../fixtures/modules/base.nj"},
+		{"./base.nj", "../fixtures/modules/main.nj", "../fixtures/modules/base.nj"},
+		{"../base.nj", "../fixtures/modules/nested/child.nj", "../fixtures/modules/base.nj"},
+		{"pathlib.nj", "../fixtures/modules/main.nj", "../fixtures/modules/vendor/pathlib.nj"},
+		{"pathlib.nj", "", "../fixtures/modules/vendor/pathlib.nj"},
+		{"missing.nj", "../fixtures/modules/main.nj", "../fixtures/modules/missing.nj"},
+		{"/tmp/../tmp/missing.nj", "../fixtures/modules/main.nj", "/tmp/missing.nj"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestResolveImport[%d]", i), func(t *testing.T) {
+			path := resolveImport(tt.name, tt.importer)
+			if path != filepath.FromSlash(tt.expected) {
+				t.Errorf("resolveImport(%q, %q) expected %q. Got: %q", tt.name, tt.importer, tt.expected, path)
+			}
+		})
+	}
+}
+
+func TestImportRelativeToImportingFile(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`import {total} from "../fixtures/modules/main.nj"; total;`, 21},
+		{`import "../fixtures/modules/base.nj" as a; import "../fixtures/modules/nested/../base.nj" as b; a == b;`, true},
+		{`import "../fixtures/modules/main.nj" as a; import "../fixtures/modules/main.nj" as b; a == b;`, true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestImportRelativeToImportingFile[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestImportFromNinjaPath(t *testing.T) {
+	t.Setenv(NinjaPathEnv, "../fixtures/modules/vendor")
+
+	evaluated := testEval(`import {origin} from "pathlib.nj"; origin;`, t)
+	testStringObject(t, evaluated, "ninja path")
+}
+
+func TestImportFromFile(t *testing.T) {
+	program := testParseProgram(t, `import {base} from "./base.nj"; base;`)
+
+	env := object.NewEnvironment()
+	env.SetFile("../fixtures/modules/entry.nj")
+
+	testIntegerObject(t, Eval(program, env), 10)
+}
+
+func TestImportCycle(t *testing.T) {
+	evaluated := testEval(`import "../fixtures/modules/cycle_a.nj";`, t)
+
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+	}
+
+	expected := "../fixtures/modules/cycle_a.nj: ../fixtures/modules/cycle_b.nj: import cycle detected: " +
+		"../fixtures/modules/cycle_a.nj -> ../fixtures/modules/cycle_b.nj -> ../fixtures/modules/cycle_a.nj IMPORT at [Line: 1, Offset: 7]"
+
+	if errObj.Message != expected {
+		t.Errorf("wrong error message. expected=%q, got=%q", expected, errObj.Message)
+	}
+
+	if len(modules.loading) != 0 {
+		t.Errorf("modules being loaded expected to be empty. Got: %v", modules.loading)
+	}
+}
+
+func TestImportCycleFromEntryFile(t *testing.T) {
+	program := testParseProgram(t, `import "./cycle_b.nj";`)
+
+	env := object.NewEnvironment()
+	env.SetFile("../fixtures/modules/cycle_a.nj")
+
+	evaluated := Eval(program, env)
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+	}
+
+	expected := "../fixtures/modules/cycle_b.nj: import cycle detected: " +
+		"../fixtures/modules/cycle_a.nj -> ../fixtures/modules/cycle_b.nj -> ../fixtures/modules/cycle_a.nj IMPORT at [Line: 1, Offset: 7]"
+
+	if errObj.Message != expected {
+		t.Errorf("wrong error message. expected=%q, got=%q", expected, errObj.Message)
+	}
+}
+
+func TestImportVendoredPackage(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"ninja.json":                   `{"name": "project"}`,
+		"vendor/helpers/main.ninja":    `export function double(x) { return x * 2; }`,
+		"vendor/helpers/strings.ninja": `export var greeting = "hello";`,
+		"src/main.ninja":               ``,
+	}
+
+	for name, content := range files {
+		filename := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	program := testParseProgram(t, `import {double} from "helpers"; import {greeting} from "helpers/strings.ninja"; greeting + " " + double(2).string();`)
+
+	env := object.NewEnvironment()
+	env.SetFile(filepath.Join(root, "src", "main.ninja"))
+
+	testStringObject(t, Eval(program, env), "hello 4")
+}
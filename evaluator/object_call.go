@@ -8,10 +8,17 @@ import (
 func evalObjectCallExpression(node *ast.Dot, env *object.Environment) object.Object {
 	// @todo check if is object.Object and if isnt error.
 	obj := Eval(node.Object, env)
+	if object.IsError(obj) {
+		return obj
+	}
+
+	if module, ok := obj.(*object.Module); ok {
+		return evalModuleAccess(module, node, env)
+	}
 
 	callExpression, ok := node.Right.(*ast.CallExpression)
 	if !ok {
-		return object.NewErrorFormat("object.call is not call expression. Got: %s", callExpression)
+		return object.NewErrorFormat("object.call is not call expression. Got: %s", node.Right)
 	}
 
 	method, ok := callExpression.Function.(*ast.Identifier)
@@ -30,5 +37,42 @@ func evalObjectCallExpression(node *ast.Dot, env *object.Environment) object.Obj
 		return args[0]
 	}
 
+	if fn, ok := enumMethod(obj, method.Value); ok {
+		return applyMethod(fn, obj, args)
+	}
+
 	return callable.Call(method.Value, args...)
 }
+
+// evalModuleAccess get exported value of module, e.g.: lib.PI or call it, e.g.: lib.add(1, 2)
+func evalModuleAccess(module *object.Module, node *ast.Dot, env *object.Environment) object.Object {
+	if ident, ok := node.Right.(*ast.Identifier); ok {
+		value, ok := module.Lookup(ident.Value)
+		if !ok {
+			return object.NewErrorFormat("module '%s' does not export %s %s", module.Name, ident.Value, ident.Token)
+		}
+		return value
+	}
+
+	callExpression, ok := node.Right.(*ast.CallExpression)
+	if !ok {
+		return object.NewErrorFormat("object.call is not call expression. Got: %s", node.Right)
+	}
+
+	method, ok := callExpression.Function.(*ast.Identifier)
+	if !ok {
+		return object.NewErrorFormat("object.call.function isn't a identifier. Got: %s", callExpression.Function)
+	}
+
+	args := evalExpressions(callExpression.Arguments, env)
+	if len(args) == 1 && object.IsError(args[0]) {
+		return args[0]
+	}
+
+	fn, ok := module.Lookup(method.Value)
+	if !ok {
+		return module.Call(method.Value, args...)
+	}
+
+	return applyFunction(fn, args)
+}
@@ -0,0 +1,168 @@
+package evaluator
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOsModule(t *testing.T) {
+	t.Setenv("NINJA_TEST_VALUE", "ninja")
+	t.Setenv("NINJA_TEST_SET", "")
+	hostname, _ := os.Hostname()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`os.env("NINJA_TEST_VALUE")`, "ninja"},
+		{`os.env("NINJA_TEST_MISSING")`, "null"},
+		{`os.env("NINJA_TEST_MISSING", "default")`, "default"},
+		{`os.setEnv("NINJA_TEST_SET", "changed"); os.env("NINJA_TEST_SET")`, "changed"},
+		{`os.environ()["NINJA_TEST_VALUE"]`, "ninja"},
+		{`os.pid()`, fmt.Sprintf("%d", os.Getpid())},
+		{`os.hostname()`, hostname},
+		{`os.exec("echo", ["hello", "world"])`, "{stdout: hello world\n, stderr: , code: 0}"},
+		{`os.exec("sh", ["-c", "echo fail >&2; exit 3"])`, "{stdout: , stderr: fail\n, code: 3}"},
+		{`os.exec("cat", [], {"stdin": "from stdin"})["stdout"]`, "from stdin"},
+		{`os.exec("sh", ["-c", "echo $NINJA_TEST_VALUE $NINJA_TEST_OTHER"], {"env": {"NINJA_TEST_OTHER": "other"}})["stdout"]`, "ninja other\n"},
+		{`os.exec("pwd", [], {"dir": "/"})["stdout"]`, "/\n"},
+		{`os.type()`, "MODULE"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestOsModule[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestOsModuleWorkingDirectory(t *testing.T) {
+	original, _ := os.Getwd()
+	defer os.Chdir(original)
+
+	dir, _ := filepath.EvalSymlinks(t.TempDir())
+	evaluated := testEval(fmt.Sprintf(`os.chdir(%q); os.cwd()`, dir), t)
+
+	if evaluated.Inspect() != dir {
+		t.Errorf("expected %q. Got: %q", dir, evaluated.Inspect())
+	}
+}
+
+func TestOsModuleExecStream(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	originalOut, originalErr := object.StandardOutput, object.StandardError
+	defer func() { object.StandardOutput, object.StandardError = originalOut, originalErr }()
+	object.StandardOutput, object.StandardError = stdout, stderr
+
+	evaluated := testEval(`os.exec("sh", ["-c", "echo out; echo err >&2"], {"stream": true})`, t)
+
+	if evaluated.Inspect() != "{stdout: out\n, stderr: err\n, code: 0}" {
+		t.Errorf("unexpected result. Got: %q", evaluated.Inspect())
+	}
+
+	if stdout.String() != "out\n" || stderr.String() != "err\n" {
+		t.Errorf("expected output to be streamed. Got: %q and %q", stdout.String(), stderr.String())
+	}
+}
+
+func TestOsModuleExecBackgroundChildren(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`os.exec("sh", ["-c", "sleep 3 & echo"], {"timeout": 300})`, "{stdout: \n, stderr: , code: 0}"},
+		{`os.exec("sh", ["-c", "sleep 3 & echo hi; exit 2"])`, "{stdout: hi\n, stderr: , code: 2}"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestOsModuleExecBackgroundChildren[%d]", i), func(t *testing.T) {
+			start := time.Now()
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("expected to not wait for children on background. Took: %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestOsModuleExecProcessGroup(t *testing.T) {
+	stat, err := os.ReadFile("/proc/self/stat")
+	if err != nil {
+		t.Skip("process groups are read from /proc")
+	}
+	group := strings.Fields(string(stat[bytes.LastIndexByte(stat, ')')+1:]))[2]
+
+	tests := []struct {
+		input     string
+		sameGroup bool
+	}{
+		{`os.exec("sh", ["-c", "cut -d')' -f2 /proc/$$/stat | cut -d' ' -f4"])`, true},
+		{`os.exec("sh", ["-c", "cut -d')' -f2 /proc/$$/stat | cut -d' ' -f4"], {"timeout": 5000})`, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestOsModuleExecProcessGroup[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input+`["stdout"].trim()`, t)
+
+			if (evaluated.Inspect() == group) != tt.sameGroup {
+				t.Errorf("expected same process group to be %v. Got: %s, ours: %s", tt.sameGroup, evaluated.Inspect(), group)
+			}
+		})
+	}
+}
+
+func TestOsModuleWrongUsage(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`os.env()`, "TypeError: os.env() takes at least 1 arguments at most 2 (0 given)"},
+		{`os.env(1)`, "TypeError: os.env() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`os.setEnv("A", 1)`, "TypeError: os.setEnv() expected argument #2 to be `STRING` got `INTEGER`"},
+		{`os.setEnv("", "a")`, "ValueError: os.setEnv() setenv: invalid argument"},
+		{`os.pid(1)`, "TypeError: os.pid() takes exactly 0 argument (1 given)"},
+		{`os.chdir("/ninja/missing")`, "IOError: os.chdir() chdir /ninja/missing: no such file or directory"},
+		{`os.exec("ninja-missing-command")`, "IOError: os.exec() exec: \"ninja-missing-command\": executable file not found in $PATH"},
+		{`os.exec("echo", [1])`, "TypeError: os.exec() expected argument 0 of command to be `STRING` got `INTEGER`"},
+		{`os.exec("echo", [], {"shell": true})`, "ValueError: os.exec() unknown option shell, expected one of stdin, env, dir, stream, timeout"},
+		{`os.exec("echo", [], {"stdin": 1})`, "TypeError: os.exec() option stdin expected to be `STRING` got `INTEGER`"},
+		{`os.exec("echo", [], {"env": {"A": 1}})`, "TypeError: os.exec() option env expected names and values to be `STRING` got STRING: INTEGER"},
+		{`os.exec("echo", [], {"timeout": 0})`, "ValueError: os.exec() timeout must be positive got 0"},
+		{`os.exec("sleep", ["5"], {"timeout": 50})`, "TimeoutError: os.exec() sleep didn't finish after 50ms"},
+		{`os.exec("sh", ["-c", "sleep 5 & sleep 5"], {"timeout": 300})`, "TimeoutError: os.exec() sh didn't finish after 300ms"},
+		{`os.exec("echo", [], {"stream": "no"})`, "TypeError: os.exec() option stream expected to be `BOOLEAN` got `STRING`"},
+		{`os.exec("echo", [], {"stream": function() {}})`, "TypeError: os.exec() option stream expected to be `BOOLEAN` got `FUNCTION`"},
+		{`os.exit()`, "method exit not exists on module os."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestOsModuleWrongUsage[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPathModule(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`path.join("a", "b", "c.txt")`, filepath.Join("a", "b", "c.txt")},
+		{`path.join("a/", "../b")`, "b"},
+		{`path.dir("/a/b/c.txt")`, "/a/b"},
+		{`path.base("/a/b/c.txt")`, "c.txt"},
+		{`path.ext("a/b.tar.gz")`, ".gz"},
+		{`path.ext("a/b")`, ""},
+		{`path.clean("a/./b/../c//d")`, "a/c/d"},
+		{`path.abs("/a/../b")`, "/b"},
+		{`path.rel("/a", "/a/b/c")`, "b/c"},
+		{`path.rel("/a/b", "/a/c")`, "../c"},
+		{`path.split("a/b/c.txt")`, "[a/b/, c.txt]"},
+		{`path.split("c.txt")`, "[, c.txt]"},
+		{`path.match("*.txt", "a.txt")`, "true"},
+		{`path.match("a/*.txt", "a/b/c.txt")`, "false"},
+		{`path.type()`, "MODULE"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestPathModule[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestPathModuleWrongUsage(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`path.join()`, "TypeError: path.join() takes a minimum 1 arguments (0 given)"},
+		{`path.join("a", 1)`, "TypeError: path.join() expected argument #2 to be `STRING` got `INTEGER`"},
+		{`path.dir(1)`, "TypeError: path.dir() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`path.base()`, "TypeError: path.base() takes exactly 1 argument (0 given)"},
+		{`path.rel("a", "/b")`, "ValueError: path.rel() Rel: can't make /b relative to a"},
+		{`path.match("[", "a")`, "ValueError: path.match() syntax error in pattern"},
+		{`path.resolve("a")`, "method resolve not exists on module path."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestPathModuleWrongUsage[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
+
+func TestFileLocation(t *testing.T) {
+	fixture, _ := filepath.Abs("../fixtures/location.nj")
+	cwd, _ := os.Getwd()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`__FILE__`, ""},
+		{`__DIR__`, cwd},
+		{`var __FILE__ = "mine"; __FILE__`, "mine"},
+		{`import "../fixtures/location.nj" as lib; lib.file`, fixture},
+		{`import "../fixtures/location.nj" as lib; lib.dir`, filepath.Dir(fixture)},
+		{`import {where} from "../fixtures/location.nj"; where()`, "location.nj"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestFileLocation[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
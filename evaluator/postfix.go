@@ -39,6 +39,9 @@ func evalPostfixExpressionAndAssing(node *ast.PostfixExpression, left object.Obj
 	}
 
 	ident := astIdent.Token
+	if env.IsConstant(ident.Literal) {
+		return object.NewErrorFormat("cannot reassign constant %s %s", ident.Literal, ident)
+	}
 	env.Set(ident.Literal, result)
 
 	return left
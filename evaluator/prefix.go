@@ -44,6 +44,9 @@ func evalPrefixExpressionAndAssing(node *ast.PrefixExpression, right object.Obje
 	}
 
 	ident := astIdent.Token
+	if env.IsConstant(ident.Literal) {
+		return object.NewErrorFormat("cannot reassign constant %s %s", ident.Literal, ident)
+	}
 	env.Set(ident.Literal, result)
 
 	return result
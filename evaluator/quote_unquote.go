@@ -0,0 +1,71 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/object"
+	"github.com/gravataLonga/ninja/token"
+	"strconv"
+)
+
+// quote return node unevaluated, except for unquote() calls inside it
+func quote(node ast.Node, env *object.Environment) object.Object {
+	node = evalUnquoteCalls(node, env)
+	return &object.Quote{Node: node}
+}
+
+func evalUnquoteCalls(quoted ast.Node, env *object.Environment) ast.Node {
+	return ast.Modify(quoted, func(node ast.Node) ast.Node {
+		call, ok := node.(*ast.CallExpression)
+		if !ok || !isCallTo(call, "unquote") || len(call.Arguments) != 1 {
+			return node
+		}
+
+		unquoted := Eval(call.Arguments[0], env)
+		converted := convertObjectToASTNode(unquoted)
+		if converted == nil {
+			return node
+		}
+		return converted
+	})
+}
+
+// isCallTo check if call expression is calling identifier name, e.g.: quote(1)
+func isCallTo(call *ast.CallExpression, name string) bool {
+	ident, ok := call.Function.(*ast.Identifier)
+	return ok && ident.Value == name
+}
+
+// convertObjectToASTNode transform object back to node, it returns nil when
+// object can't be represented as a node.
+func convertObjectToASTNode(obj object.Object) ast.Node {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		t := token.Token{Type: token.INT, Literal: fmt.Sprintf("%d", obj.Value)}
+		return &ast.IntegerLiteral{Token: t, Value: obj.Value}
+	case *object.BigInt:
+		t := token.Token{Type: token.INT, Literal: obj.Value.String()}
+		return &ast.IntegerLiteral{Token: t, Big: obj.Value}
+	case *object.Float:
+		t := token.Token{Type: token.FLOAT, Literal: strconv.FormatFloat(obj.Value, 'f', -1, 64)}
+		return &ast.FloatLiteral{Token: t, Value: obj.Value}
+	case *object.Decimal:
+		t := token.Token{Type: token.DECIMAL, Literal: obj.String() + "d"}
+		return &ast.DecimalLiteral{Token: t, Value: obj.Value, Scale: obj.Scale}
+	case *object.String:
+		t := token.Token{Type: token.STRING, Literal: obj.Value}
+		return &ast.StringLiteral{Token: t, Value: obj.Value}
+	case *object.Boolean:
+		var t token.Token
+		if obj.Value {
+			t = token.Token{Type: token.TRUE, Literal: "true"}
+		} else {
+			t = token.Token{Type: token.FALSE, Literal: "false"}
+		}
+		return &ast.Boolean{Token: t, Value: obj.Value}
+	case *object.Quote:
+		return obj.Node
+	default:
+		return nil
+	}
+}
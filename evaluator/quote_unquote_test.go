@@ -0,0 +1,68 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`quote(5)`, `5`},
+		{`quote(5 + 8)`, `(5 + 8)`},
+		{`quote(foobar)`, `foobar`},
+		{`quote(foobar + barfoo)`, `(foobar + barfoo)`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestQuote[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testQuoteObject(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestQuoteUnquote(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`quote(unquote(4))`, `4`},
+		{`quote(unquote(4 + 4))`, `8`},
+		{`quote(8 + unquote(4 + 4))`, `(8 + 8)`},
+		{`quote(unquote(4 + 4) + 8)`, `(8 + 8)`},
+		{`var foobar = 8; quote(foobar)`, `foobar`},
+		{`var foobar = 8; quote(unquote(foobar))`, `8`},
+		{`quote(unquote(true))`, `true`},
+		{`quote(unquote(true == false))`, `false`},
+		{`quote(unquote(1.5))`, `1.5`},
+		{`quote(unquote("ninja"))`, `ninja`},
+		{`quote(unquote(quote(4 + 4)))`, `(4 + 4)`},
+		{`var quotedInfixExpression = quote(4 + 4); quote(unquote(4 + 4) + unquote(quotedInfixExpression))`, `(8 + (4 + 4))`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestQuoteUnquote[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testQuoteObject(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func testQuoteObject(t *testing.T, evaluated object.Object, expected string) {
+	quote, ok := evaluated.(*object.Quote)
+	if !ok {
+		t.Fatalf("expected *object.Quote. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	if quote.Node == nil {
+		t.Fatalf("quote.Node is nil")
+	}
+
+	if quote.Node.String() != expected {
+		t.Errorf("not equal. got=%q, want=%q", quote.Node.String(), expected)
+	}
+}
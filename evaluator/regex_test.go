@@ -0,0 +1,84 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestRegex(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`regex("a+")`, `regex("a+")`},
+		{`regex("a+", "i")`, `regex("a+", "i")`},
+		{`regex("a+").type()`, "REGEX"},
+		{`regex("a+", "i").source()`, "a+"},
+		{`regex("\\d+").test("abc 123")`, "true"},
+		{`regex("^\\d+$").test("abc 123")`, "false"},
+		{`regex("abc", "i").test("ABC")`, "true"},
+		{`regex("^b", "m").test("a\nb")`, "true"},
+		{`regex("\\d+").find("abc 123 45")`, "123"},
+		{`regex("\\d+").find("abc")`, "null"},
+		{`regex("\\d+").findAll("1 22 333")`, "[1, 22, 333]"},
+		{`regex("\\d+").findAll("abc")`, "[]"},
+		{`regex("(\\w+)@(\\w+)").match("mail: ana@home")`, "{match: ana@home, index: 6, groups: [ana, home], named: {}}"},
+		{`regex("(?P<user>\\w+)@(?P<host>\\w+)").match("ana@home")["named"]`, "{user: ana, host: home}"},
+		{`regex("(a)|(b)").match("b")["groups"]`, "[null, b]"},
+		{`regex("b").match("çãb")["index"]`, "2"},
+		{`regex("x").match("abc")`, "null"},
+		{`regex("(?P<n>\\d)").matchAll("a1b2").map(function(m) { return m["named"]["n"] })`, "[1, 2]"},
+		{`regex("\\d").matchAll("abc")`, "[]"},
+		{`regex("(\\w+)@(\\w+)").replace("ana@home bob@work", "$2:$1")`, "home:ana work:bob"},
+		{`regex("(?P<n>\\d+)").replace("a1b22", "<${n}>")`, "a<1>b<22>"},
+		{`regex("\\d+").replace("a1b22", function(m) { return (m["match"].int() * 2).string() })`, "a2b44"},
+		{`regex("\\s*,\\s*").split("a , b,c")`, "[a, b, c]"},
+		{`regex(",").split("a,b,c", 2)`, "[a, b,c]"},
+		{`var r: regex = regex("a"); r.test("a")`, "true"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestRegex[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			if evaluated.Inspect() != tt.expected {
+				t.Errorf("expected %q. Got: %q", tt.expected, evaluated.Inspect())
+			}
+		})
+	}
+}
+
+func TestRegexWrongUsage(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`regex()`, "TypeError: regex() takes at least 1 arguments at most 2 (0 given)"},
+		{`regex(1)`, "TypeError: regex() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`regex("(a")`, "SyntaxError: regex() error parsing regexp: missing closing ): `(a`"},
+		{`regex("a", "x")`, "ValueError: regex() unknown flag x, expected one of i, m, s, U"},
+		{`regex("a").test(1)`, "TypeError: regex.test() expected argument #1 to be `STRING` got `INTEGER`"},
+		{`regex("a").match()`, "TypeError: regex.match() takes exactly 1 argument (0 given)"},
+		{`regex("a").replace("a", 1)`, "TypeError: regex.replace() expected argument #2 to be `STRING` or `FUNCTION` got `INTEGER`"},
+		{`regex("a").replace("a", function(m) { return 1 })`, "TypeError: regex.replace() expected function to return `STRING` got `INTEGER`"},
+		{`regex("a").replace("a", function(m) { return [].foo() })`, "method foo not exists on array object."},
+		{`regex("a").split("a", "1")`, "TypeError: regex.split() expected argument #2 to be `INTEGER` got `STRING`"},
+		{`regex("a").exec("a")`, "method exec not exists on regex object."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestRegexWrongUsage[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
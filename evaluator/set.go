@@ -0,0 +1,45 @@
+package evaluator
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/object"
+)
+
+func evalSetLiteral(
+	node *ast.SetLiteral,
+	env *object.Environment,
+) object.Object {
+	elements := evalExpressions(node.Elements, env)
+	if len(elements) == 1 && object.IsError(elements[0]) {
+		return elements[0]
+	}
+
+	set, err := object.NewSet(elements...)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return set
+}
+
+func evalSetInfixExpression(
+	operator string,
+	left, right object.Object,
+) object.Object {
+	leftSet := left.(*object.Set)
+	rightSet := right.(*object.Set)
+
+	switch operator {
+	case "|":
+		return leftSet.Union(rightSet)
+	case "&":
+		return leftSet.Intersection(rightSet)
+	case "-":
+		return leftSet.Difference(rightSet)
+	case "==":
+		return nativeBoolToBooleanObject(leftSet.Equal(rightSet))
+	case "!=":
+		return nativeBoolToBooleanObject(!leftSet.Equal(rightSet))
+	default:
+		return object.NewErrorFormat("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
@@ -0,0 +1,111 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestSetExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{1, 2, 3}`, "{1, 2, 3}"},
+		{`{1, 2, 2, 1}`, "{1, 2}"},
+		{`{"a", 1, true, 1.5}`, "{a, 1, true, 1.500000}"},
+		{`set()`, "set()"},
+		{`set([3, 1, 3])`, "{3, 1}"},
+		{`set({1, 2})`, "{1, 2}"},
+		{`{1, 2, 3} | {3, 4}`, "{1, 2, 3, 4}"},
+		{`{1, 2, 3} & {2, 3, 4}`, "{2, 3}"},
+		{`{1, 2, 3} - {2}`, "{1, 3}"},
+		{`{1, 2} - {1, 2}`, "set()"},
+		{`{1, 2}.union({5})`, "{1, 2, 5}"},
+		{`{1, 2}.intersection({2})`, "{2}"},
+		{`{1, 2}.difference({2})`, "{1}"},
+		{`var a = {1}; a.add(2, 3, 2); a;`, "{1, 2, 3}"},
+		{`var a = {1, 2, 3}; a.remove(2); a;`, "{1, 3}"},
+		{`var a = {1, 2}; var b = a | set(); b.add(3); a;`, "{1, 2}"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestSetExpression[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			set, ok := evaluated.(*object.Set)
+			if !ok {
+				t.Fatalf("object is not Set. got=%T (%+v)", evaluated, evaluated)
+			}
+
+			if set.Inspect() != tt.expected {
+				t.Errorf("set has wrong elements. got=%s, want=%s", set.Inspect(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`{1, 2}.type()`, "SET"},
+		{`{1, 2}.length()`, 2},
+		{`len({1, 2, 2})`, 2},
+		{`{1, 2}.has(1)`, true},
+		{`{1, 2}.has("1")`, false},
+		{`{1, 2}.has([1])`, false},
+		{`{1, 2}.remove(1)`, true},
+		{`{1, 2}.remove(3)`, false},
+		{`{1, 2}.isSubset({1, 2, 3})`, true},
+		{`{1, 4}.isSubset({1, 2, 3})`, false},
+		{`{1, 2} == {2, 1}`, true},
+		{`{1, 2} == {1, 2, 3}`, false},
+		{`{1, 2} != {1}`, true},
+		{`{1, 2}.values()`, object.Array{Elements: []object.Object{&object.Integer{Value: 1}, &object.Integer{Value: 2}}}},
+		{`var total = 0; var v = {1, 2, 3}.values(); for (var i = 0; i < len(v); i++) { total = total + v[i]; } total;`, 6},
+		{`function (s: set): int { return len(s); }({1})`, 1},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestSetMethods[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestSetErrors(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{`{[{"a": 1}]}`, "unusable as set element: ARRAY"},
+		{`{1}.add({"a": 1})`, "TypeError: set.add() unusable as set element: HASH"},
+		{`set([[function() {}]])`, "TypeError: set() unusable as set element: ARRAY"},
+		{`var a = [1]; a.push(a); freeze(a); {1}.add(a)`, "TypeError: set.add() unusable as set element: ARRAY"},
+		{`set(1)`, "TypeError: set() expected argument #1 to be `ARRAY or SET` got `INTEGER`"},
+		{`{1}.union([1])`, "TypeError: set.union() expected argument #1 to be `SET` got `ARRAY`"},
+		{`{1}.add()`, "TypeError: set.add() takes a minimum 1 arguments (0 given)"},
+		{`{1} + {2}`, "unknown operator: SET + SET"},
+		{`var a = freeze({1}); a.add(2);`, "TypeError: set.add() cannot modify frozen set"},
+		{`var a = freeze({1}); a.remove(1);`, "TypeError: set.remove() cannot modify frozen set"},
+		{`{1}.ups()`, "method ups not exists on set object."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestSetErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
@@ -49,6 +49,6 @@ func evalStringIndexExpression(str, index object.Object) object.Object {
 	}
 
 	return &object.String{
-		Value: string([]rune(stringObject.Value)[idx.Value]),
+		Value: string(rn[idx.Value]),
 	}
 }
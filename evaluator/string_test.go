@@ -787,3 +787,399 @@ func TestStringMethodNotFound(t *testing.T) {
 		t.Errorf("error message expected to be %s. got: %s", expected, errObj)
 	}
 }
+
+func TestStringUnicodeIsRuneBased(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"ação"[1]`, "ç"},
+		{`"ação"[3]`, "o"},
+		{`"ação"[4]`, nil},
+		{`"ação".index("ão")`, 2},
+		{`"ação".index("x")`, -1},
+		{`"日本語".index("語")`, 2},
+		{`len("ação")`, 4},
+		{`"ação".length()`, 4},
+		{`var out = ""; var s = "ação"; for (var i = 0; i < len(s); i++) { out = s[i] + out; }; out;`, "oãça"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringUnicodeIsRuneBased[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringMethodSlice(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"ação".slice(1)`, "ção"},
+		{`"ação".slice(1, 3)`, "çã"},
+		{`"ação".slice(-2)`, "ão"},
+		{`"ação".slice(0, -1)`, "açã"},
+		{`"ação".slice(3, 1)`, ""},
+		{`"ação".slice(10)`, ""},
+		{`"ação".slice(-10, 100)`, "ação"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringMethodSlice[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testStringObject(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringMethodChars(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected object.Array
+	}{
+		{`"".chars()`, stringArray()},
+		{`"ação".chars()`, stringArray("a", "ç", "ã", "o")},
+		// "e" followed by combining acute accent
+		{`"e\u0301!".chars()`, stringArray("e\u0301", "!")},
+		{`"👍🏽👍".chars()`, stringArray("👍🏽", "👍")},
+		{`"👨‍👩‍👧 🇵🇹🇧🇷".chars()`, stringArray("👨‍👩‍👧", " ", "🇵🇹", "🇧🇷")},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringMethodChars[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringMethodNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"e\u0301".normalize() == "\u00e9"`, true},
+		{`"e\u0301".length()`, 2},
+		{`"e\u0301".normalize().length()`, 1},
+		{`"\u00e9".normalize("NFD").length()`, 2},
+		{`"ﬁ".normalize("nfkc")`, "fi"},
+		{`"ﬁ".normalize("NFKD")`, "fi"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringMethodNormalize[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringMethodFold(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"Straße".fold()`, "strasse"},
+		{`"HELLO".fold() == "hello".fold()`, true},
+		{`"Straße".equalFold("STRASSE")`, true},
+		{`"ÁGUA".equalFold("água")`, true},
+		{`"agua".equalFold("água")`, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringMethodFold[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringMethodCodepoint(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"a".codepoint()`, 97},
+		{`"ação".codepoint(1)`, 231},
+		{`"😀".codepoint()`, 128512},
+		{`fromCodepoint(110, 105, 110, 106, 97)`, "ninja"},
+		{`fromCodepoint("ação".codepoint(2))`, "ã"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringMethodCodepoint[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestStringUnicodeMethodsWrongParameter(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{
+			`"ola".slice()`,
+			"TypeError: string.slice() takes at least 1 arguments at most 2 (0 given)",
+		},
+		{
+			`"ola".slice("1")`,
+			"TypeError: string.slice() expected argument #1 to be `INTEGER` got `STRING`",
+		},
+		{
+			`"ola".chars(1)`,
+			"TypeError: string.chars() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".normalize("NFX")`,
+			"ValueError: string.normalize() unknown form NFX, expected one of NFC, NFD, NFKC or NFKD",
+		},
+		{
+			`"ola".fold(1)`,
+			"TypeError: string.fold() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".equalFold(1)`,
+			"TypeError: string.equalFold() expected argument #1 to be `STRING` got `INTEGER`",
+		},
+		{
+			`"ola".codepoint(3)`,
+			"IndexError: string.codepoint() position 3 out of range of string with length 3",
+		},
+		{
+			`"".codepoint()`,
+			"IndexError: string.codepoint() position 0 out of range of string with length 0",
+		},
+		{
+			`fromCodepoint()`,
+			"TypeError: fromCodepoint() takes a minimum 1 arguments (0 given)",
+		},
+		{
+			`fromCodepoint(97, "b")`,
+			"TypeError: fromCodepoint() expected argument #2 to be `INTEGER` got `STRING`",
+		},
+		{
+			`fromCodepoint(55296)`,
+			"ValueError: fromCodepoint() argument #1 55296 isn't a valid code point",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringUnicodeMethodsWrongParameter[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
+
+func stringArray(values ...string) object.Array {
+	elements := make([]object.Object, len(values))
+	for i, v := range values {
+		elements[i] = &object.String{Value: v}
+	}
+	return object.Array{Elements: elements}
+}
+
+func TestStringExtendedMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"hello".startsWith("he")`, true},
+		{`"hello".startsWith("lo")`, false},
+		{`"hello".endsWith("lo")`, true},
+		{`"hello".endsWith("he")`, false},
+		{`"ab".repeat(3)`, "ababab"},
+		{`"ab".repeat(0)`, ""},
+		{`"7".padLeft(3, "0")`, "007"},
+		{`"7".padLeft(3)`, "  7"},
+		{`"7".padLeft(6, "ab")`, "ababa7"},
+		{`"7".padLeft(-9223372036854775807 - 1)`, "7"},
+		{`"ab".padRight(5, "xy")`, "abxyx"},
+		{`"hello".padRight(2)`, "hello"},
+		{`"héllo".reverse()`, "olléh"},
+		{`"éa".reverse()`, "aé"},
+		{`"hello".substr(1, 3)`, "ell"},
+		{`"hello".substr(-3)`, "llo"},
+		{`"hello".substr(3, 10)`, "lo"},
+		{`"hello".substr(10)`, ""},
+		{`"hello".lastIndex("l")`, 3},
+		{`"héllo".lastIndex("o")`, 4},
+		{`"hello".lastIndex("z")`, -1},
+		{`"banana".count("a")`, 3},
+		{`"banana".count("z")`, 0},
+		{`"xxhixx".trimLeft("x")`, "hixx"},
+		{`"xxhixx".trimRight("x")`, "xxhi"},
+		{`"  hi  ".trimLeft()`, "hi  "},
+		{`"  hi  ".trimRight()`, "  hi"},
+		{`"a\nb\r\nc\n".lines()`, stringArray("a", "b", "c")},
+		{`"a\n\nb".lines()`, stringArray("a", "", "b")},
+		{`"".lines()`, stringArray()},
+		{`" a  b\tc ".words()`, stringArray("a", "b", "c")},
+		{`"hello wORLD".title()`, "Hello World"},
+		{`"hello World".capitalize()`, "Hello world"},
+		{`"".capitalize()`, ""},
+		{`"{} + {} = {2}".format(1, 2, 3)`, "1 + 2 = 3"},
+		{`"{1}{0}".format("a", "b")`, "ba"},
+		{`"{name} is {{x}}".format({"name": "ninja"})`, "ninja is {x}"},
+		{`"{}".format([1, 2])`, "[1, 2]"},
+		{`"no placeholders".format()`, "no placeholders"},
+		{`"123".isNumeric()`, true},
+		{`"12.3".isNumeric()`, false},
+		{`"".isNumeric()`, false},
+		{`"héllo".isAlpha()`, true},
+		{`"hello1".isAlpha()`, false},
+		{`"".isAlpha()`, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringExtendedMethods[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			switch expected := tt.expected.(type) {
+			case string:
+				testStringObject(t, evaluated, expected)
+			default:
+				testObjectLiteral(t, evaluated, expected)
+			}
+		})
+	}
+}
+
+func TestStringExtendedMethodsWrongParameter(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedErrorMessage string
+	}{
+		{
+			`"ola".startsWith(1)`,
+			"TypeError: string.startsWith() expected argument #1 to be `STRING` got `INTEGER`",
+		},
+		{
+			`"ola".endsWith()`,
+			"TypeError: string.endsWith() takes exactly 1 argument (0 given)",
+		},
+		{
+			`"ola".repeat(-1)`,
+			"ValueError: string.repeat() count must not be negative got -1",
+		},
+		{
+			`"ab".repeat(9223372036854775807)`,
+			"ValueError: string.repeat() result would be larger than 1073741824 bytes",
+		},
+		{
+			`"ab".repeat(536870913)`,
+			"ValueError: string.repeat() result would be larger than 1073741824 bytes",
+		},
+		{
+			`"a".padLeft(9223372036854775807)`,
+			"ValueError: string.padLeft() result would be larger than 1073741824 bytes",
+		},
+		{
+			`"a".padRight(2000000000, "é")`,
+			"ValueError: string.padRight() result would be larger than 1073741824 bytes",
+		},
+		{
+			`"ola".padLeft("1")`,
+			"TypeError: string.padLeft() expected argument #1 to be `INTEGER` got `STRING`",
+		},
+		{
+			`"ola".padRight(5, "")`,
+			"ValueError: string.padRight() pad must not be empty",
+		},
+		{
+			`"ola".reverse(1)`,
+			"TypeError: string.reverse() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".substr(0, -1)`,
+			"ValueError: string.substr() length must not be negative got -1",
+		},
+		{
+			`"ola".substr()`,
+			"TypeError: string.substr() takes at least 1 arguments at most 2 (0 given)",
+		},
+		{
+			`"ola".lastIndex(1)`,
+			"TypeError: string.lastIndex() expected argument #1 to be `STRING` got `INTEGER`",
+		},
+		{
+			`"ola".count()`,
+			"TypeError: string.count() takes exactly 1 argument (0 given)",
+		},
+		{
+			`"ola".trimLeft(1)`,
+			"TypeError: string.trimLeft() expected argument #1 to be `STRING` got `INTEGER`",
+		},
+		{
+			`"ola".trimRight("a", "b")`,
+			"TypeError: string.trimRight() takes at least 0 arguments at most 1 (2 given)",
+		},
+		{
+			`"ola".lines(1)`,
+			"TypeError: string.lines() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".words(1)`,
+			"TypeError: string.words() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".title(1)`,
+			"TypeError: string.title() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"ola".capitalize(1)`,
+			"TypeError: string.capitalize() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"{} {}".format(1)`,
+			"IndexError: string.format() placeholder #1 out of range of 1 arguments",
+		},
+		{
+			`"{3}".format(1)`,
+			"IndexError: string.format() placeholder {3} out of range of 1 arguments",
+		},
+		{
+			`"{name}".format({"other": 1})`,
+			"KeyError: string.format() missing value for placeholder {name}",
+		},
+		{
+			`"{".format(1)`,
+			"ValueError: string.format() unclosed placeholder at 0",
+		},
+		{
+			`"1".isNumeric(1)`,
+			"TypeError: string.isNumeric() takes exactly 0 argument (1 given)",
+		},
+		{
+			`"a".isAlpha(1)`,
+			"TypeError: string.isAlpha() takes exactly 0 argument (1 given)",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestStringExtendedMethodsWrongParameter[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedErrorMessage {
+				t.Errorf("error message expected to be: \"%s\". got: \"%s\"", tt.expectedErrorMessage, errObj.Message)
+			}
+		})
+	}
+}
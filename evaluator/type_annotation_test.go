@@ -0,0 +1,72 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"testing"
+)
+
+func TestTypeAnnotation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`function add(a: int, b: int): int { return a + b; }; add(1, 2);`, 3},
+		{`function add(a: number, b: number = 2.5): number { return a + b; }; add(1);`, 3.5},
+		{`var name: string = "ninja"; name;`, "ninja"},
+		{`function (a: any) { return a; }(true);`, true},
+		{`function (f: function) { return f(); }(function () { return 1; });`, 1},
+		{`function (f: function) { return f([1, 2]); }(len);`, 2},
+		{`enum Color { case RED; } function (c: Color): Color { return c; }(Color::RED) == Color::RED;`, true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestTypeAnnotation[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+			testObjectLiteral(t, evaluated, tt.expected)
+		})
+	}
+}
+
+func TestTypeAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{
+			`function add(a: int, b: int) { return a + b; }; add(1, "2");`,
+			"TypeError: function expected argument #2 (b) to be `int` got `STRING` at { at [Line: 1, Offset: 30]",
+		},
+		{
+			`function (a: float = 1.0) { return a; }(1);`,
+			"TypeError: function expected argument #1 (a) to be `float` got `INTEGER` at { at [Line: 1, Offset: 27]",
+		},
+		{
+			`function (): string { return 1; }();`,
+			"TypeError: function expected to return `string` got `INTEGER` at { at [Line: 1, Offset: 21]",
+		},
+		{
+			`function (): int { }();`,
+			"TypeError: function expected to return `int` got `NULL` at { at [Line: 1, Offset: 18]",
+		},
+		{
+			`enum Color { case RED; } enum Size { case BIG; } function (c: Color) { }(Size::BIG);`,
+			"TypeError: function expected argument #1 (c) to be `Color` got `ENUM_VALUE` at { at [Line: 1, Offset: 70]",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestTypeAnnotationErrors[%d]", i), func(t *testing.T) {
+			evaluated := testEval(tt.input, t)
+
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Fatalf("no error object returned. got=%T(%+v)", evaluated, evaluated)
+			}
+
+			if errObj.Message != tt.expectedMessage {
+				t.Errorf("wrong error message. expected=%q, got=%q", tt.expectedMessage, errObj.Message)
+			}
+		})
+	}
+}
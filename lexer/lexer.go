@@ -1,53 +1,75 @@
 package lexer
 
 import (
+	"bufio"
 	"encoding/hex"
+	"fmt"
 	"github.com/gravataLonga/ninja/token"
 	"io"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// char is a rune decoded from input, a malformed byte sequence is kept as it is
+// so it can be reported.
+type char struct {
+	r       rune
+	invalid byte // byte which isn't valid UTF-8, when r is utf8.RuneError
+}
+
 type Lexer struct {
-	input        string
-	position     int
-	readPosition int
-	ch           byte
+	reader *bufio.Reader
+	ch     rune // current rune, 0 when there isn't more input
+
+	invalid byte  // ch is a malformed UTF-8 byte
+	next    *char // next rune when it was already peeked
 
 	lineNumber              int
 	characterPositionInLine int
+
+	errors []string
 }
 
 func New(in io.Reader) *Lexer {
-	r, err := io.ReadAll(in)
-	if err != nil {
-		return nil
-	}
-	l := &Lexer{input: string(r), lineNumber: 0}
+	l := &Lexer{reader: bufio.NewReader(in), lineNumber: 0}
 	l.readChar()
 	return l
 }
 
+// Errors get errors found while reading input, like malformed UTF-8
+func (l *Lexer) Errors() []string {
+	return l.errors
+}
+
 func (l *Lexer) NextToken() token.Token {
 	var tok token.Token
 	l.skipWhitespace()
+
+	if l.invalid != 0 {
+		tok = l.newToken(token.ILLEGAL, string([]byte{l.invalid}))
+		l.newError("invalid UTF-8 encoding %#x %s", l.invalid, tok)
+		l.readChar()
+		return tok
+	}
+
 	switch l.ch {
 	case '=':
-		tok = l.newTokenPeekOrDefault(token.ASSIGN, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.ASSIGN, map[rune]token.TokenType{
 			'=': token.EQ,
 		})
 	case ';':
-		tok = l.newToken(token.SEMICOLON, []byte{l.ch})
+		tok = l.newToken(token.SEMICOLON, string(l.ch))
 	case '"':
 		str, err := l.readString()
 		if err != nil {
-			tok = l.newToken(token.ILLEGAL, []byte{l.ch})
+			tok = l.newToken(token.ILLEGAL, string(l.ch))
 		} else {
-			tok.Type = token.STRING
-			tok.Literal = str
+			tok = l.newToken(token.STRING, str)
 		}
 	case '*':
-		tok = l.newTokenPeekOrDefault(token.ASTERISK, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.ASTERISK, map[rune]token.TokenType{
 			'*': token.EXPONENCIAL,
 		})
 	case '/':
@@ -60,80 +82,80 @@ func (l *Lexer) NextToken() token.Token {
 			l.skipMultiLineComment()
 			return l.NextToken()
 		}
-		tok = l.newToken(token.SLASH, []byte{l.ch})
+		tok = l.newToken(token.SLASH, string(l.ch))
 	case '&':
-		tok = l.newTokenPeekOrDefault(token.BIT_AND, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.BIT_AND, map[rune]token.TokenType{
 			'&': token.AND,
 		})
 	case '|':
-		tok = l.newTokenPeekOrDefault(token.BIT_OR, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.BIT_OR, map[rune]token.TokenType{
 			'|': token.OR,
 		})
 	case '^':
-		tok = l.newToken(token.BIT_XOR, []byte{l.ch})
+		tok = l.newToken(token.BIT_XOR, string(l.ch))
 	case '~':
-		tok = l.newToken(token.BIT_NOT, []byte{l.ch})
+		tok = l.newToken(token.BIT_NOT, string(l.ch))
 	case '-':
-		tok = l.newTokenPeekOrDefault(token.MINUS, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.MINUS, map[rune]token.TokenType{
 			'-': token.DECRE,
 		})
 	case '+':
-		tok = l.newTokenPeekOrDefault(token.PLUS, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.PLUS, map[rune]token.TokenType{
 			'+': token.INCRE,
 		})
 	case '%':
-		tok = l.newTokenPeekOrDefault(token.MOD, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.MOD, map[rune]token.TokenType{
 			'%': token.MOD,
 		})
 	case '>':
-		tok = l.newTokenPeekOrDefault(token.GT, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.GT, map[rune]token.TokenType{
 			'=': token.GTE,
 			'>': token.SHIFT_RIGHT,
 		})
 	case '<':
-		tok = l.newTokenPeekOrDefault(token.LT, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.LT, map[rune]token.TokenType{
 			'=': token.LTE,
 			'<': token.SHIFT_LEFT,
 		})
 	case '!':
-		tok = l.newTokenPeekOrDefault(token.BANG, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.BANG, map[rune]token.TokenType{
 			'=': token.NEQ,
 		})
 	case ':':
-		tok = l.newTokenPeekOrDefault(token.COLON, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.COLON, map[rune]token.TokenType{
 			':': token.DOUBLE_COLON,
 		})
 	case '(':
-		tok = l.newToken(token.LPAREN, []byte{l.ch})
+		tok = l.newToken(token.LPAREN, string(l.ch))
 	case ')':
-		tok = l.newToken(token.RPAREN, []byte{l.ch})
+		tok = l.newToken(token.RPAREN, string(l.ch))
 	case '{':
-		tok = l.newToken(token.LBRACE, []byte{l.ch})
+		tok = l.newToken(token.LBRACE, string(l.ch))
 	case '}':
-		tok = l.newToken(token.RBRACE, []byte{l.ch})
+		tok = l.newToken(token.RBRACE, string(l.ch))
 	case '[':
-		tok = l.newToken(token.LBRACKET, []byte{l.ch})
+		tok = l.newToken(token.LBRACKET, string(l.ch))
 	case ']':
-		tok = l.newToken(token.RBRACKET, []byte{l.ch})
+		tok = l.newToken(token.RBRACKET, string(l.ch))
 	case ',':
-		tok = l.newToken(token.COMMA, []byte{l.ch})
+		tok = l.newToken(token.COMMA, string(l.ch))
 	case '?':
-		tok = l.newTokenPeekOrDefault(token.QUESTION_MARK, map[byte]token.TokenType{
+		tok = l.newTokenPeekOrDefault(token.QUESTION_MARK, map[rune]token.TokenType{
 			':': token.ELVIS_OPERATOR,
 		})
 	case '.':
-		tok = l.newToken(token.DOT, []byte{l.ch})
+		tok = l.newToken(token.DOT, string(l.ch))
 	case 0:
-		tok = l.newToken(token.EOF, []byte{0})
+		tok = l.newToken(token.EOF, "\x00")
 	default:
 		if isLetter(l.ch) {
 			literal := l.readIdentifier()
-			return l.newToken(token.LookupIdentifier(literal), literal)
+			return l.newToken(token.LookupIdentifier([]byte(literal)), literal)
 		} else if isDigit(l.ch) {
 			digitType, literal := l.readDigit()
 			return l.newToken(digitType.TokenType(), literal)
 		} else {
-			tok = l.newToken(token.ILLEGAL, []byte{l.ch})
+			tok = l.newToken(token.ILLEGAL, string(l.ch))
 		}
 	}
 
@@ -141,22 +163,39 @@ func (l *Lexer) NextToken() token.Token {
 	return tok
 }
 
+// readChar decode next rune from input
 func (l *Lexer) readChar() {
-
-	if l.readPosition >= len(l.input) {
-		l.ch = 0
-	} else {
-		l.ch = l.input[l.readPosition]
+	c := l.next
+	if c == nil {
+		c = l.decode()
 	}
+	l.next = nil
 
-	l.position = l.readPosition
-	l.readPosition += 1
+	l.ch = c.r
+	l.invalid = c.invalid
 
 	l.keepTrackLineAndCharPosition()
 }
 
+func (l *Lexer) decode() *char {
+	r, size, err := l.reader.ReadRune()
+	if err != nil {
+		if err != io.EOF {
+			l.newError("error reading input: %s", err)
+		}
+		return &char{}
+	}
+
+	if r == utf8.RuneError && size == 1 {
+		_ = l.reader.UnreadRune()
+		b, _ := l.reader.ReadByte()
+		return &char{r: r, invalid: b}
+	}
+	return &char{r: r}
+}
+
 // keepTrackLineAndCharPosition is a method which keep tracking where position of
-// pointer of lexer is point at.
+// pointer of lexer is point at, offset count runes and not bytes.
 func (l *Lexer) keepTrackLineAndCharPosition() {
 	if l.ch == '\n' {
 		l.lineNumber += 1
@@ -166,93 +205,128 @@ func (l *Lexer) keepTrackLineAndCharPosition() {
 	}
 }
 
-func (l *Lexer) newToken(tokenType token.TokenType, ch []byte) token.Token {
+func (l *Lexer) newError(format string, a ...interface{}) {
+	l.errors = append(l.errors, fmt.Sprintf(format, a...))
+}
+
+func (l *Lexer) newToken(tokenType token.TokenType, literal string) token.Token {
 	location := token.Location{Line: l.lineNumber + 1, Offset: l.characterPositionInLine}
-	return token.Token{Type: tokenType, Literal: string(ch), Location: location}
+	return token.Token{Type: tokenType, Literal: literal, Location: location}
 }
 
-func (l *Lexer) newTokenPeekOrDefault(tokenType token.TokenType, expectedPeek map[byte]token.TokenType) token.Token {
+func (l *Lexer) newTokenPeekOrDefault(tokenType token.TokenType, expectedPeek map[rune]token.TokenType) token.Token {
 	peekToken, ok := expectedPeek[l.peekChar()]
 	if !ok {
-		return l.newToken(tokenType, []byte{l.ch})
+		return l.newToken(tokenType, string(l.ch))
 	}
 
 	ch := l.ch
 	l.readChar()
-	return l.newToken(peekToken, []byte{ch, l.ch})
+	return l.newToken(peekToken, string([]rune{ch, l.ch}))
 }
 
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+// isLetter tell if rune can start an identifier, any unicode letter is accepted
+func isLetter(ch rune) bool {
+	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' ||
+		ch >= utf8.RuneSelf && unicode.IsLetter(ch)
 }
 
-func isDigit(ch byte) bool {
+// isIdentifierPart tell if rune can be part of an identifier after first one,
+// combining marks are accepted, so words of scripts like devanagari are valid.
+func isIdentifierPart(ch rune) bool {
+	return isLetter(ch) || isDigit(ch) ||
+		ch >= utf8.RuneSelf && (unicode.IsDigit(ch) || unicode.In(ch, unicode.Mn, unicode.Mc))
+}
+
+func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9'
 }
 
-func isHexDigit(ch byte) bool {
+func isHexDigit(ch rune) bool {
 	return isDigit(ch) || ('a' <= ch && ch <= 'f') || ('A' <= ch && ch <= 'F')
 }
 
-func isScientificNotation(ch byte) bool {
+func isScientificNotation(ch rune) bool {
 	return ch == 'e' || ch == 'E'
 }
 
-func (l *Lexer) readIdentifier() []byte {
-	position := l.position
-	for isLetter(l.ch) || isDigit(l.ch) {
+func (l *Lexer) readIdentifier() string {
+	b := &strings.Builder{}
+	for isIdentifierPart(l.ch) {
+		b.WriteRune(l.ch)
 		l.readChar()
 	}
-	return []byte(l.input[position:l.position])
+	return b.String()
 }
 
-// readDigit read integer, float, hex digits and scientific notations.
-func (l *Lexer) readDigit() (token.DigitType, []byte) {
-	position := l.position
-	currentDigitType := token.DIGIT_TYPE_DECIMAL
+// basePrefixes are prefixes of integers in other base than decimal, e.g.: 0x1F
+var basePrefixes = map[rune]token.DigitType{
+	'x': token.DIGIT_TYPE_HEXADECIMAL,
+	'X': token.DIGIT_TYPE_HEXADECIMAL,
+	'b': token.DIGIT_TYPE_BINARY,
+	'B': token.DIGIT_TYPE_BINARY,
+	'o': token.DIGIT_TYPE_OCTAL,
+	'O': token.DIGIT_TYPE_OCTAL,
+}
 
-	for {
-		if isDigit(l.ch) && l.peekChar() == 'x' {
-			currentDigitType = token.DIGIT_TYPE_HEXADECIMAL
-			l.readChar()
-			continue
-		}
+// readDigit read integer, float, hex, binary, octal digits, exact decimals and
+// scientific notations. Digits can be separated by "_", e.g.: 1_000_000, parser is
+// responsible for checking if literal is well-formed.
+func (l *Lexer) readDigit() (token.DigitType, string) {
+	b := &strings.Builder{}
 
-		if l.ch == 'x' && currentDigitType.IsEqual(token.DIGIT_TYPE_HEXADECIMAL) {
-			l.readChar()
-			continue
-		}
+	if digitType, ok := basePrefixes[l.peekChar()]; ok && l.ch == '0' {
+		b.WriteRune(l.ch)
+		l.readChar()
+		b.WriteRune(l.ch)
+		l.readChar()
 
-		if isHexDigit(l.ch) && currentDigitType.IsEqual(token.DIGIT_TYPE_HEXADECIMAL) {
+		for isHexDigit(l.ch) || l.ch == '_' {
+			b.WriteRune(l.ch)
 			l.readChar()
-			continue
 		}
+		return digitType, b.String()
+	}
 
+	currentDigitType := token.DIGIT_TYPE_DECIMAL
+	for {
 		if l.ch == '.' && isDigit(l.peekChar()) {
 			currentDigitType = token.DIGIT_TYPE_FLOAT
+			b.WriteRune(l.ch)
 			l.readChar()
 			continue
 		}
 
 		if l.ch == '-' && currentDigitType.IsEqual(token.DIGIT_TYPE_SCIENTIFIC_NOTATION) {
+			b.WriteRune(l.ch)
 			l.readChar()
 			continue
 		}
 
 		if isScientificNotation(l.ch) && !currentDigitType.IsEqual(token.DIGIT_TYPE_SCIENTIFIC_NOTATION) {
 			currentDigitType = token.DIGIT_TYPE_SCIENTIFIC_NOTATION
+			b.WriteRune(l.ch)
 			l.readChar()
 			continue
 		}
 
-		if isDigit(l.ch) {
+		if isDigit(l.ch) || l.ch == '_' {
+			b.WriteRune(l.ch)
 			l.readChar()
 			continue
 		}
 
 		break
 	}
-	return currentDigitType, []byte(l.input[position:l.position])
+
+	// suffix "d" make an exact decimal, e.g.: 12.30d
+	isPlainNumber := currentDigitType.IsEqual(token.DIGIT_TYPE_DECIMAL) || currentDigitType.IsEqual(token.DIGIT_TYPE_FLOAT)
+	if isPlainNumber && l.ch == 'd' && !isIdentifierPart(l.peekChar()) {
+		b.WriteRune(l.ch)
+		l.readChar()
+		return token.DIGIT_TYPE_EXACT_DECIMAL, b.String()
+	}
+	return currentDigitType, b.String()
 }
 
 func (l *Lexer) skipSingleLineComment() {
@@ -265,7 +339,7 @@ func (l *Lexer) skipSingleLineComment() {
 
 func (l *Lexer) skipMultiLineComment() {
 
-	for !(l.ch == '*' && l.peekChar() == '/') || l.ch == 0 {
+	for !(l.ch == '*' && l.peekChar() == '/') && l.ch != 0 {
 		l.readChar()
 	}
 	l.readChar() // "*"
@@ -280,17 +354,23 @@ func (l *Lexer) skipWhitespace() {
 	}
 }
 
-func (l *Lexer) peekChar() byte {
-	if l.readPosition >= len(l.input) {
-		return 0
+// peekChar look at next rune without consuming it
+func (l *Lexer) peekChar() rune {
+	if l.next == nil {
+		l.next = l.decode()
 	}
-	return l.input[l.readPosition]
+	return l.next.r
 }
 
 func (l *Lexer) readString() (string, error) {
 	b := &strings.Builder{}
 	for {
 		l.readChar()
+		if l.invalid != 0 {
+			tok := l.newToken(token.STRING, "")
+			l.newError("invalid UTF-8 encoding %#x %s", l.invalid, tok)
+		}
+
 		// Support some basic escapes like \"
 		if l.ch == '\\' {
 			switch l.peekChar() {
@@ -311,8 +391,8 @@ func (l *Lexer) readString() (string, error) {
 			case '/':
 				b.WriteByte('/')
 			case 'u':
-				// Skip over the the '\\', 'u' and the next four bytes (unicode)
-				chars := []byte{}
+				// Skip over the the '\\', 'u' and the next four runes (unicode)
+				chars := []rune{}
 				l.readChar()
 				for n := 0; n <= 3; n++ {
 					chars = append(chars, l.ch)
@@ -327,12 +407,12 @@ func (l *Lexer) readString() (string, error) {
 				b.WriteString(dst)
 				continue
 			case 'x':
-				// Skip over the the '\\', 'x' and the next two bytes (hex)
+				// Skip over the the '\\', 'x' and the next two runes (hex)
 				l.readChar()
 				l.readChar()
 				prevCh := l.ch
 				l.readChar()
-				src := string([]byte{prevCh, l.ch})
+				src := string([]rune{prevCh, l.ch})
 				dst, err := hex.DecodeString(src)
 				if err != nil {
 					return "", err
@@ -348,7 +428,7 @@ func (l *Lexer) readString() (string, error) {
 				break
 			}
 		}
-		b.WriteByte(l.ch)
+		b.WriteRune(l.ch)
 	}
 	return b.String(), nil
 }
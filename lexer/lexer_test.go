@@ -5,6 +5,7 @@ import (
 	"github.com/gravataLonga/ninja/token"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestNextToken(t *testing.T) {
@@ -299,6 +300,26 @@ func TestLexerReadNumber(t *testing.T) {
 			`0x1234567890ABCDEF`,
 			token.INT,
 		},
+		{
+			`12.30d`,
+			`12.30d`,
+			token.DECIMAL,
+		},
+		{
+			`5d`,
+			`5d`,
+			token.DECIMAL,
+		},
+		{
+			`1_000.50d;`,
+			`1_000.50d`,
+			token.DECIMAL,
+		},
+		{
+			`1.5days`,
+			`1.5`,
+			token.FLOAT,
+		},
 	}
 
 	for i, tt := range tests {
@@ -317,3 +338,126 @@ func TestLexerReadNumber(t *testing.T) {
 
 	}
 }
+
+func TestLexerUnicodeIdentifiers(t *testing.T) {
+	input := `var café = ação + नमस्ते_1 + _π2; 日本`
+	tests := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.VAR, "var"},
+		{token.IDENT, "café"},
+		{token.ASSIGN, "="},
+		{token.IDENT, "ação"},
+		{token.PLUS, "+"},
+		{token.IDENT, "नमस्ते_1"},
+		{token.PLUS, "+"},
+		{token.IDENT, "_π2"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "日本"},
+		{token.EOF, "\x00"},
+	}
+
+	l := New(strings.NewReader(input))
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+
+	if len(l.Errors()) != 0 {
+		t.Errorf("lexer expected to not have errors. Got: %v", l.Errors())
+	}
+}
+
+func TestLexerLocationCountRunes(t *testing.T) {
+	input := "var ação = \"olá\";\n日本 == 1;"
+	tests := []struct {
+		expectedLiteral string
+		expectedLine    int
+		expectedOffset  int
+	}{
+		{"var", 1, 4},
+		{"ação", 1, 9},
+		{"=", 1, 10},
+		{"olá", 1, 16},
+		{";", 1, 17},
+		{"日本", 2, 3},
+		{"==", 2, 5},
+		{"1", 2, 8},
+		{";", 2, 8},
+	}
+
+	l := New(strings.NewReader(input))
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, tt.expectedLiteral, tok.Literal)
+		}
+
+		if tok.Line != tt.expectedLine || tok.Offset != tt.expectedOffset {
+			t.Errorf("tests[%d] - location of %q wrong. expected=[%d, %d], got=[%d, %d]", i, tok.Literal, tt.expectedLine, tt.expectedOffset, tok.Line, tok.Offset)
+		}
+	}
+}
+
+func TestLexerMalformedUtf8(t *testing.T) {
+	input := "var a\xff = \"b\xfec\";"
+
+	l := New(strings.NewReader(input))
+
+	expected := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.VAR, "var"},
+		{token.IDENT, "a"},
+		{token.ILLEGAL, "\xff"},
+		{token.ASSIGN, "="},
+		{token.STRING, "b�c"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, "\x00"},
+	}
+
+	for i, tt := range expected {
+		tok := l.NextToken()
+		if tok.Type != tt.expectedType || tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - expected %s %q. Got: %s %q", i, tt.expectedType, tt.expectedLiteral, tok.Type, tok.Literal)
+		}
+	}
+
+	errors := []string{
+		"invalid UTF-8 encoding 0xff ILLEGAL at [Line: 1, Offset: 6]",
+		"invalid UTF-8 encoding 0xfe STRING at [Line: 1, Offset: 12]",
+	}
+
+	if len(l.Errors()) != len(errors) {
+		t.Fatalf("lexer expected %d errors. Got: %v", len(errors), l.Errors())
+	}
+
+	for i, err := range errors {
+		if l.Errors()[i] != err {
+			t.Errorf("lexer error expected %q. Got: %q", err, l.Errors()[i])
+		}
+	}
+}
+
+func TestLexerReadIncrementally(t *testing.T) {
+	input := `var ação = "नमस्ते";`
+
+	l := New(iotest.OneByteReader(strings.NewReader(input)))
+
+	literals := []string{"var", "ação", "=", "नमस्ते", ";", "\x00"}
+	for i, literal := range literals {
+		tok := l.NextToken()
+		if tok.Literal != literal {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, literal, tok.Literal)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/gravataLonga/ninja/object"
 	"github.com/gravataLonga/ninja/parser"
 	"github.com/gravataLonga/ninja/repl"
+	"github.com/gravataLonga/ninja/semantic"
+	"github.com/gravataLonga/ninja/stdlib"
 	flag "github.com/spf13/pflag"
 	"io"
 	"os"
@@ -19,11 +21,12 @@ var version string
 
 var exec = flag.StringP("exec", "e", "", "Runs the given code.")
 var astS = flag.BoolP("ast", "a", false, "Return AST structure")
+var fsRoot = flag.String("fs-root", "", "Restrict fs module to files inside of given directory.")
 
 func main() {
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Version: %s. \nUsage: ninja [flags] [program file] [arguments]\n\nAvailable flags:\n", version)
+		fmt.Fprintf(os.Stderr, "Version: %s. \nUsage: ninja [flags] [program file] [arguments]\n       ninja init [name]\n       ninja install [name source]\n       ninja update [name ...]\n\nAvailable flags:\n", version)
 
 		flag.PrintDefaults()
 	}
@@ -33,72 +36,107 @@ func main() {
 
 	object.StandardInput = os.Stdin
 	object.StandardOutput = os.Stdout
+	object.StandardError = os.Stderr
 	object.Arguments = args
 	object.ExitFunction = os.Exit
 
+	if err := stdlib.SetFileSystemRoot(*fsRoot); err != nil {
+		fmt.Fprintf(os.Stderr, "--fs-root: %v\n", err)
+		os.Exit(1)
+		return
+	}
+
 	if len(os.Args) == 1 {
 		runRepl(os.Stdin, os.Stdout)
 		return
 	}
 
+	if ok, err := runCommand(args, ".", os.Stdout); ok {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if len(*exec) > 0 {
-		execCode(*exec, os.Stdout)
+		os.Exit(execCode(*exec, os.Stdout, os.Stderr))
+		return
+	}
+
+	if len(args) == 0 {
+		runRepl(os.Stdin, os.Stdout)
 		return
 	}
 
-	file, err := os.ReadFile(os.Args[1])
+	file, err := os.ReadFile(args[0])
 	if err != nil {
-		_, err := fmt.Fprintf(os.Stderr, "%v", err)
-		if err != nil {
-			os.Exit(1)
-			return
-		}
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 		return
 	}
 
-	execCode(string(file), os.Stdout)
+	os.Exit(execFile(string(file), args[0], os.Stdout, os.Stderr))
 }
 
 func runRepl(in io.Reader, out io.Writer) {
+	object.StandardOutput = out
 	replProgram := repl.NewRepel(out, in)
 	replProgram.Version(version)
 
 	replProgram.Start()
 }
 
-func execCode(input string, writer io.Writer) {
+func execCode(input string, stdout io.Writer, stderr io.Writer) int {
+	return execFile(input, "", stdout, stderr)
+}
+
+// execFile run input read from filename, imports are resolved relative to it.
+// Program output goes to stdout and errors to stderr, it return exit code.
+func execFile(input string, filename string, stdout io.Writer, stderr io.Writer) int {
+	object.StandardOutput = stdout
+	object.StandardError = stderr
+
 	env := object.NewEnvironment()
+	env.SetFile(filename)
 	l := lexer.New(strings.NewReader(input))
 	p := parser.New(l)
-	// s := semantic.New()
 
 	program := p.ParseProgram()
 	if len(p.Errors()) > 0 {
-		printParserErrors(p.Errors(), writer)
-		return
+		printErrors("parser", p.Errors(), stderr)
+		return 1
+	}
+
+	macroEnv := object.NewEnvironment()
+	evaluator.DefineMacros(program, macroEnv)
+	expanded, errors := evaluator.ExpandMacros(program, macroEnv)
+	if len(errors) != 0 {
+		printErrors("macro", errors, stderr)
+		return 1
 	}
 
-	/*program = s.Analysis(program)
+	s := semantic.New(expanded)
+	node := s.Analysis()
 	if len(s.Errors()) != 0 {
-		printSemanticErrorsErrors(s.Errors(), writer)
-		return
-	}*/
-	evaluated := evaluator.Eval(program, env)
-	if evaluated != nil {
-		fmt.Fprintf(writer, evaluated.Inspect())
+		printErrors("semantic", s.Errors(), stderr)
+		return 1
 	}
-}
 
-func printParserErrors(errors []string, writer io.Writer) {
-	fmt.Fprintf(writer, "🔥 Fire at core! parser errors:")
-	for _, msg := range errors {
-		fmt.Fprintf(writer, "\t %s\n", msg)
+	evaluated := evaluator.Eval(node, env)
+	if object.IsError(evaluated) {
+		fmt.Fprintln(stderr, evaluated.Inspect())
+		return 1
+	}
+
+	if evaluated != nil {
+		fmt.Fprint(stdout, evaluated.Inspect())
 	}
+	return 0
 }
 
-func printSemanticErrorsErrors(errors []string, writer io.Writer) {
-	fmt.Fprintf(writer, "🔥 Fire at core! semantic errors:")
+func printErrors(kind string, errors []string, writer io.Writer) {
+	fmt.Fprintf(writer, "🔥 Fire at core! %s errors:\n", kind)
 	for _, msg := range errors {
 		fmt.Fprintf(writer, "\t %s\n", msg)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/gravataLonga/ninja/object"
 	"github.com/gravataLonga/ninja/parser"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -62,7 +63,7 @@ func TestMain_execCode(t *testing.T) {
 		t.Fatalf("%s: %s", "TestMain_execCode", err)
 	}
 
-	execCode(`var a = 2 + 1; a;`, temporaryStdOut)
+	execCode(`var a = 2 + 1; a;`, temporaryStdOut, os.Stderr)
 
 	resultOut, err := os.ReadFile(temporaryStdOut.Name())
 	if err != nil {
@@ -82,7 +83,7 @@ func TestMain_execCodeSpecialCharacter(t *testing.T) {
 		t.Fatalf("%s: %s", "TestMain_execCode", err)
 	}
 
-	execCode("import \"./testdata/multiple_lines.ninja\"; input.split(\"\n\")", temporaryStdOut)
+	execCode("import {input} from \"./testdata/multiple_lines.ninja\"; input.split(\"\n\")", temporaryStdOut, os.Stderr)
 
 	resultOut, err := os.ReadFile(temporaryStdOut.Name())
 	if err != nil {
@@ -104,7 +105,7 @@ func TestMain_execCodeAssertions(t *testing.T) {
 
 	code := readFile(t, "./testdata/assertions.ninja")
 	expected := readFile(t, "./testdata/expected.txt")
-	execCode(code, temporaryStdOut)
+	execCode(code, temporaryStdOut, os.Stderr)
 
 	resultOut, err := os.ReadFile(temporaryStdOut.Name())
 	if err != nil {
@@ -116,6 +117,40 @@ func TestMain_execCodeAssertions(t *testing.T) {
 	}
 }
 
+func TestMain_execCodeOutputStreams(t *testing.T) {
+	tests := []struct {
+		input    string
+		stdout   string
+		stderr   string
+		exitCode int
+	}{
+		{`puts("out"); eputs("err"); print("a"); eprint("b"); 1`, "out\na1", "err\nb", 0},
+		{`printf("%d\n", 1); [1].foo()`, "1\n", "ERROR: method foo not exists on array object.\n", 1},
+		{`var a = ;`, "", "🔥 Fire at core! parser errors:\n", 1},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestMain_execCodeOutputStreams[%d]", i), func(t *testing.T) {
+			stdout := &strings.Builder{}
+			stderr := &strings.Builder{}
+
+			exitCode := execCode(tt.input, stdout, stderr)
+
+			if exitCode != tt.exitCode {
+				t.Errorf("exit code expected %d. Got: %d", tt.exitCode, exitCode)
+			}
+
+			if stdout.String() != tt.stdout {
+				t.Errorf("stdout expected %q. Got: %q", tt.stdout, stdout.String())
+			}
+
+			if !strings.HasPrefix(stderr.String(), tt.stderr) {
+				t.Errorf("stderr expected %q. Got: %q", tt.stderr, stderr.String())
+			}
+		})
+	}
+}
+
 func readFile(t *testing.T, filename string) string {
 	file, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -139,3 +174,64 @@ func createStdInOut(name string) (*os.File, func(), error) {
 		os.Stdout = originalStdOut
 	}, nil
 }
+
+func TestMain_runCommand(t *testing.T) {
+	dir := t.TempDir()
+	helpers := filepath.Join(dir, "helpers")
+	project := filepath.Join(dir, "project")
+	for _, d := range []string{helpers, project} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(helpers, "main.ninja"), []byte(`export var name = "helpers";`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &strings.Builder{}
+	if ok, err := runCommand([]string{"init"}, project, out); !ok || err != nil {
+		t.Fatalf("TestMain_runCommand: init expected to succeed. Got: %v", err)
+	}
+
+	if out.String() != "created ninja.json for project\n" {
+		t.Errorf("TestMain_runCommand: init output. Got: %q", out.String())
+	}
+
+	out.Reset()
+	if ok, err := runCommand([]string{"install", "helpers", "../helpers"}, project, out); !ok || err != nil {
+		t.Fatalf("TestMain_runCommand: install expected to succeed. Got: %v", err)
+	}
+
+	if !strings.HasPrefix(out.String(), "installed helpers from ../helpers (sha256-") {
+		t.Errorf("TestMain_runCommand: install output. Got: %q", out.String())
+	}
+
+	if err := os.WriteFile(filepath.Join(helpers, "main.ninja"), []byte(`export var name = "updated";`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := runCommand([]string{"install"}, project, out); !ok || err == nil {
+		t.Fatalf("TestMain_runCommand: install expected to fail after helpers changed")
+	}
+
+	out.Reset()
+	if ok, err := runCommand([]string{"update", "helpers"}, project, out); !ok || err != nil {
+		t.Fatalf("TestMain_runCommand: update expected to succeed. Got: %v", err)
+	}
+
+	if !strings.HasPrefix(out.String(), "installed helpers from ../helpers (sha256-") {
+		t.Errorf("TestMain_runCommand: update output. Got: %q", out.String())
+	}
+
+	if ok, _ := runCommand([]string{"main.ninja"}, project, out); ok {
+		t.Errorf("TestMain_runCommand: main.ninja isn't a command")
+	}
+
+	program := filepath.Join(project, "main.ninja")
+	out.Reset()
+	execFile(`import {name} from "helpers"; name;`, program, out, os.Stderr)
+	if out.String() != "updated" {
+		t.Errorf("TestMain_runCommand: vendored package expected to be imported. Got: %q", out.String())
+	}
+}
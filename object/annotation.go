@@ -0,0 +1,50 @@
+package object
+
+// annotationTypes map type names used on annotations into object types,
+// "any" accept every object.
+var annotationTypes = map[string][]ObjectType{
+	"int":      {INTEGER_OBJ, BIGINT_OBJ},
+	"float":    {FLOAT_OBJ},
+	"decimal":  {DECIMAL_OBJ},
+	"number":   {INTEGER_OBJ, BIGINT_OBJ, FLOAT_OBJ, DECIMAL_OBJ},
+	"string":   {STRING_OBJ},
+	"bool":     {BOOLEAN_OBJ},
+	"array":    {ARRAY_OBJ},
+	"hash":     {HASH_OBJ},
+	"set":      {SET_OBJ},
+	"regex":    {REGEX_OBJ},
+	"function": {FUNCTION_OBJ, BUILTIN_OBJ},
+	"any":      nil,
+}
+
+// IsBuiltinAnnotation tell if name is a builtin type, others names can only
+// refer to enums.
+func IsBuiltinAnnotation(name string) bool {
+	_, ok := annotationTypes[name]
+	return ok
+}
+
+// MatchAnnotation check if object is of type declared on annotation, names
+// which aren't builtin types are matched against enum name of a case.
+func MatchAnnotation(name string, obj Object) bool {
+	if obj == nil {
+		obj = NULL
+	}
+
+	types, ok := annotationTypes[name]
+	if !ok {
+		ev, isEnum := obj.(*EnumValue)
+		return isEnum && ev.Enum.Name == name
+	}
+
+	if types == nil {
+		return true
+	}
+
+	for _, t := range types {
+		if obj.Type() == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package object
+
+import "testing"
+
+func TestMatchAnnotation(t *testing.T) {
+	enum := &Enum{Name: "STATUS", Branches: map[string]Object{}}
+	ok := enum.AddCase("OK", nil, nil)
+
+	tests := []struct {
+		annotation string
+		obj        Object
+		expected   bool
+	}{
+		{"int", &Integer{Value: 1}, true},
+		{"int", &Float{Value: 1.0}, false},
+		{"float", &Float{Value: 1.0}, true},
+		{"number", &Integer{Value: 1}, true},
+		{"number", &Float{Value: 1.0}, true},
+		{"number", &String{Value: "1"}, false},
+		{"string", &String{Value: "a"}, true},
+		{"bool", TRUE, true},
+		{"bool", NULL, false},
+		{"array", &Array{}, true},
+		{"hash", &Hash{Pairs: map[HashKey]HashPair{}}, true},
+		{"function", &Builtin{}, true},
+		{"function", &FunctionLiteral{}, true},
+		{"any", NULL, true},
+		{"any", nil, true},
+		{"int", nil, false},
+		{"STATUS", ok, true},
+		{"OTHER", ok, false},
+		{"STATUS", &Integer{Value: 1}, false},
+	}
+
+	for i, tt := range tests {
+		if MatchAnnotation(tt.annotation, tt.obj) != tt.expected {
+			t.Errorf("[%d] MatchAnnotation(%q) expected %t", i, tt.annotation, tt.expected)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 type Array struct {
 	Elements []Object
+	frozen   bool
 }
 
 func (ao *Array) Type() ObjectType { return ARRAY_OBJ }
@@ -40,6 +41,19 @@ func (s *Array) Clone() Object {
 	return &arr
 }
 
+// Freeze make array and all of it is elements read-only
+func (s *Array) Freeze() {
+	if s.frozen {
+		return
+	}
+	s.frozen = true
+	for _, e := range s.Elements {
+		Freeze(e)
+	}
+}
+
+func (s *Array) IsFrozen() bool { return s.frozen }
+
 func (s *Array) Call(method string, args ...Object) Object {
 	switch method {
 	case "type":
@@ -77,6 +91,58 @@ func (s *Array) Call(method string, args ...Object) Object {
 		return shiftValue
 	case "slice":
 		return arraySlice(s.Elements, args...)
+	case "map":
+		return arrayMap(s, args...)
+	case "filter":
+		return arrayFilter(s, args...)
+	case "reduce":
+		return arrayReduce(s, args...)
+	case "find":
+		return arrayFind(s, args...)
+	case "findIndex":
+		index, err := arrayFindIndex("array.findIndex", s, args...)
+		if err != nil {
+			return err
+		}
+		return &Integer{Value: int64(index)}
+	case "some":
+		return arraySome(s, args...)
+	case "every":
+		return arrayEvery(s, args...)
+	case "flatMap":
+		return arrayFlatMap(s, args...)
+	case "flatten":
+		return arrayFlatten(s, args...)
+	case "sort":
+		return arraySort(s, args...)
+	case "reverse":
+		return arrayReverse(s, args...)
+	case "unique":
+		return arrayUnique(s, args...)
+	case "zip":
+		return arrayZip(s, args...)
+	case "chunk":
+		return arrayChunk(s, args...)
+	case "groupBy":
+		return arrayGroupBy(s, args...)
+	case "indexOf":
+		index, err := arrayIndexOf("array.indexOf", s, args...)
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Integer{Value: int64(index)}
+	case "contains":
+		index, err := arrayIndexOf("array.contains", s, args...)
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return nativeBoolToBooleanObject(index >= 0)
+	case "insert":
+		return arrayInsert(s, args...)
+	case "remove":
+		return arrayRemove(s, args...)
+	case "unshift":
+		return arrayUnshift(s, args...)
 	}
 	return NewErrorFormat("method %s not exists on array object.", method)
 }
@@ -146,6 +212,10 @@ func arrayPush(array *Array, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.push() cannot modify frozen array")
+	}
+
 	for _, v := range args {
 		array.Elements = append(array.Elements, v)
 	}
@@ -163,6 +233,10 @@ func arrayPop(array *Array, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.pop() cannot modify frozen array")
+	}
+
 	if len(array.Elements) <= 0 {
 		return NULL
 	}
@@ -183,6 +257,10 @@ func arrayShift(array *Array, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.shift() cannot modify frozen array")
+	}
+
 	if len(array.Elements) <= 0 {
 		return NULL
 	}
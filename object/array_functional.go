@@ -0,0 +1,624 @@
+package object
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// callableArgument check if argument on position is a function or builtin
+func callableArgument(position int) CheckFunc {
+	return func(name string, args []Object) error {
+		if position >= len(args) {
+			return nil
+		}
+
+		switch args[position].Type() {
+		case FUNCTION_OBJ, BUILTIN_OBJ:
+			return nil
+		}
+
+		return fmt.Errorf(
+			"TypeError: %s() expected argument #%d to be `%s` got `%s`",
+			name, position+1, FUNCTION_OBJ, args[position].Type(),
+		)
+	}
+}
+
+// applyCallback call fn with as many arguments as it declare, e.g.:
+// map(function(v) {...}) only receive value while map(function(v, i) {...})
+// receive value and index. Builtins, which arity is unknown, receive required
+// arguments.
+func applyCallback(fn Object, required int, args ...Object) Object {
+	if ApplyFunction == nil {
+		return NewErrorFormat("unable to call function %s", fn.Inspect())
+	}
+
+	total := required
+	if literal, ok := fn.(*FunctionLiteral); ok {
+		total = len(literal.Parameters)
+	}
+
+	if total > len(args) {
+		total = len(args)
+	}
+
+	result := ApplyFunction(fn, args[:total])
+	if result == nil {
+		return NULL
+	}
+	return result
+}
+
+func arrayMap(array *Array, args ...Object) Object {
+	err := Check(
+		"array.map", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := make([]Object, len(array.Elements))
+	for i, e := range array.Elements {
+		result := applyCallback(args[0], 1, e, &Integer{Value: int64(i)})
+		if IsError(result) {
+			return result
+		}
+		elements[i] = result
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayFilter(array *Array, args ...Object) Object {
+	err := Check(
+		"array.filter", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := []Object{}
+	for i, e := range array.Elements {
+		result := applyCallback(args[0], 1, e, &Integer{Value: int64(i)})
+		if IsError(result) {
+			return result
+		}
+
+		if IsTruthy(result) {
+			elements = append(elements, e)
+		}
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayReduce(array *Array, args ...Object) Object {
+	err := Check(
+		"array.reduce", args,
+		RangeOfArgs(1, 2),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := array.Elements
+	start := 0
+	var accumulator Object
+	if len(args) == 2 {
+		accumulator = args[1]
+	} else {
+		if len(elements) == 0 {
+			return NewErrorFormat("TypeError: array.reduce() of empty array with no initial value")
+		}
+		accumulator = elements[0]
+		start = 1
+	}
+
+	for i := start; i < len(elements); i++ {
+		accumulator = applyCallback(args[0], 2, accumulator, elements[i], &Integer{Value: int64(i)})
+		if IsError(accumulator) {
+			return accumulator
+		}
+	}
+	return accumulator
+}
+
+// arrayFindIndex get index of first element which fn return truthy value, -1
+// when there isn't any
+func arrayFindIndex(name string, array *Array, args ...Object) (int, Object) {
+	err := Check(
+		name, args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return -1, NewError(err.Error())
+	}
+
+	for i, e := range array.Elements {
+		result := applyCallback(args[0], 1, e, &Integer{Value: int64(i)})
+		if IsError(result) {
+			return -1, result
+		}
+
+		if IsTruthy(result) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func arrayFind(array *Array, args ...Object) Object {
+	index, err := arrayFindIndex("array.find", array, args...)
+	if err != nil {
+		return err
+	}
+
+	if index < 0 {
+		return NULL
+	}
+	return array.Elements[index]
+}
+
+func arraySome(array *Array, args ...Object) Object {
+	index, err := arrayFindIndex("array.some", array, args...)
+	if err != nil {
+		return err
+	}
+	return nativeBoolToBooleanObject(index >= 0)
+}
+
+func arrayEvery(array *Array, args ...Object) Object {
+	err := Check(
+		"array.every", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	for i, e := range array.Elements {
+		result := applyCallback(args[0], 1, e, &Integer{Value: int64(i)})
+		if IsError(result) {
+			return result
+		}
+
+		if !IsTruthy(result) {
+			return FALSE
+		}
+	}
+	return TRUE
+}
+
+func arrayFlatMap(array *Array, args ...Object) Object {
+	err := Check(
+		"array.flatMap", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	mapped := arrayMap(array, args...)
+	if IsError(mapped) {
+		return mapped
+	}
+	return &Array{Elements: flatten(mapped.(*Array).Elements, 1)}
+}
+
+func arrayFlatten(array *Array, args ...Object) Object {
+	err := Check(
+		"array.flatten", args,
+		RangeOfArgs(0, 1),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	depth := int64(1)
+	if len(args) == 1 {
+		depth = args[0].(*Integer).Value
+	}
+
+	if depth < 0 {
+		return NewErrorFormat("ValueError: array.flatten() depth must not be negative got %d", depth)
+	}
+	return &Array{Elements: flatten(array.Elements, depth)}
+}
+
+func flatten(elements []Object, depth int64) []Object {
+	result := []Object{}
+	for _, e := range elements {
+		arr, ok := e.(*Array)
+		if !ok || depth == 0 {
+			result = append(result, e)
+			continue
+		}
+		result = append(result, flatten(arr.Elements, depth-1)...)
+	}
+	return result
+}
+
+func arraySort(array *Array, args ...Object) Object {
+	err := Check(
+		"array.sort", args,
+		RangeOfArgs(0, 1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := make([]Object, len(array.Elements))
+	copy(elements, array.Elements)
+
+	var failure Object
+	sort.SliceStable(elements, func(i, j int) bool {
+		if failure != nil {
+			return false
+		}
+
+		if len(args) == 0 {
+			result, ok := compareObjects(elements[i], elements[j])
+			if !ok {
+				failure = NewErrorFormat("TypeError: array.sort() unable to compare `%s` with `%s`", elements[i].Type(), elements[j].Type())
+				return false
+			}
+			return result < 0
+		}
+
+		result := applyCallback(args[0], 2, elements[i], elements[j])
+		if IsError(result) {
+			failure = result
+			return false
+		}
+
+		order, ok := result.(*Integer)
+		if !ok {
+			failure = NewErrorFormat("TypeError: array.sort() comparator expected to return `%s` got `%s`", INTEGER_OBJ, result.Type())
+			return false
+		}
+		return order.Value < 0
+	})
+
+	if failure != nil {
+		return failure
+	}
+	return &Array{Elements: elements}
+}
+
+// compareObjects compare numbers by their value, strings lexicographically and
+// any other Comparable object with same type.
+func compareObjects(left, right Object) (int, bool) {
+	if IsNumber(left) && IsNumber(right) {
+		return compareNumbers(left, right), true
+	}
+
+	if l, ok := left.(*String); ok {
+		r, ok := right.(*String)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(l.Value, r.Value), true
+	}
+
+	comparable, ok := left.(Comparable)
+	if !ok || left.Type() != right.Type() {
+		return 0, false
+	}
+
+	if left.Type() == ENUM_VALUE_OBJ && left.(*EnumValue).Enum != right.(*EnumValue).Enum {
+		return 0, false
+	}
+	return int(comparable.Compare(right)), true
+}
+
+func compareNumbers(left, right Object) int {
+	if left.Type() == DECIMAL_OBJ || right.Type() == DECIMAL_OBJ {
+		l, okl := ToDecimal(left)
+		r, okr := ToDecimal(right)
+		if okl && okr {
+			return int(l.Compare(r))
+		}
+	}
+
+	if left.Type() == FLOAT_OBJ || right.Type() == FLOAT_OBJ {
+		l, r := numberToFloat(left), numberToFloat(right)
+		switch {
+		case l < r:
+			return -1
+		case l > r:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	l, _ := ToBigInt(left)
+	r, _ := ToBigInt(right)
+	return l.Cmp(r)
+}
+
+func numberToFloat(o Object) float64 {
+	switch o := o.(type) {
+	case *Integer:
+		return float64(o.Value)
+	case *Float:
+		return o.Value
+	case *BigInt:
+		return o.Float()
+	case *Decimal:
+		return o.Float()
+	}
+	return 0
+}
+
+func arrayReverse(array *Array, args ...Object) Object {
+	err := Check(
+		"array.reverse", args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	length := len(array.Elements)
+	elements := make([]Object, length)
+	for i, e := range array.Elements {
+		elements[length-1-i] = e
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayUnique(array *Array, args ...Object) Object {
+	err := Check(
+		"array.unique", args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	seen := map[HashKey]bool{}
+	elements := []Object{}
+	for _, e := range array.Elements {
+		key, ok := HashKeyOf(e)
+		if !ok {
+			return NewErrorFormat("TypeError: array.unique() unusable element: %s", e.Type())
+		}
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		elements = append(elements, e)
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayZip(array *Array, args ...Object) Object {
+	err := Check(
+		"array.zip", args,
+		MinimumArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	length := len(array.Elements)
+	arrays := make([]*Array, len(args))
+	for i, arg := range args {
+		other, ok := arg.(*Array)
+		if !ok {
+			return NewErrorFormat("TypeError: array.zip() expected argument #%d to be `%s` got `%s`", i+1, ARRAY_OBJ, arg.Type())
+		}
+
+		arrays[i] = other
+		if len(other.Elements) < length {
+			length = len(other.Elements)
+		}
+	}
+
+	elements := make([]Object, length)
+	for i := 0; i < length; i++ {
+		tuple := []Object{array.Elements[i]}
+		for _, other := range arrays {
+			tuple = append(tuple, other.Elements[i])
+		}
+		elements[i] = &Array{Elements: tuple}
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayChunk(array *Array, args ...Object) Object {
+	err := Check(
+		"array.chunk", args,
+		ExactArgs(1),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	size := args[0].(*Integer).Value
+	if size <= 0 {
+		return NewErrorFormat("ValueError: array.chunk() size must be greater than 0 got %d", size)
+	}
+
+	elements := []Object{}
+	for start := int64(0); start < int64(len(array.Elements)); start += size {
+		end := start + size
+		if end > int64(len(array.Elements)) {
+			end = int64(len(array.Elements))
+		}
+
+		chunk := make([]Object, end-start)
+		copy(chunk, array.Elements[start:end])
+		elements = append(elements, &Array{Elements: chunk})
+	}
+	return &Array{Elements: elements}
+}
+
+func arrayGroupBy(array *Array, args ...Object) Object {
+	err := Check(
+		"array.groupBy", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	hash := NewHash()
+	for i, e := range array.Elements {
+		group := applyCallback(args[0], 1, e, &Integer{Value: int64(i)})
+		if IsError(group) {
+			return group
+		}
+
+		key, ok := HashKeyOf(group)
+		if !ok {
+			return NewErrorFormat("TypeError: array.groupBy() unusable as hash key: %s", group.Type())
+		}
+
+		pair, ok := hash.Get(key)
+		if !ok {
+			pair = HashPair{Key: group, Value: &Array{Elements: []Object{}}}
+			hash.Set(key, pair)
+		}
+
+		elements := pair.Value.(*Array)
+		elements.Elements = append(elements.Elements, e)
+	}
+	return hash
+}
+
+// equalObjects tell if objects are same, hashable objects are compared by value
+func equalObjects(left, right Object) bool {
+	if left == right {
+		return true
+	}
+
+	l, ok := HashKeyOf(left)
+	if !ok {
+		return false
+	}
+
+	r, ok := HashKeyOf(right)
+	return ok && l == r
+}
+
+func arrayIndexOf(name string, array *Array, args ...Object) (int, error) {
+	err := Check(
+		name, args,
+		ExactArgs(1),
+	)
+
+	if err != nil {
+		return -1, err
+	}
+
+	for i, e := range array.Elements {
+		if equalObjects(e, args[0]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func arrayInsert(array *Array, args ...Object) Object {
+	err := Check(
+		"array.insert", args,
+		MinimumArgs(2),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.insert() cannot modify frozen array")
+	}
+
+	index := args[0].(*Integer).Value
+	if index < 0 || index > int64(len(array.Elements)) {
+		return NewErrorFormat("IndexError: array.insert() index %d out of range of array with length %d", index, len(array.Elements))
+	}
+
+	elements := make([]Object, 0, len(array.Elements)+len(args)-1)
+	elements = append(elements, array.Elements[:index]...)
+	elements = append(elements, args[1:]...)
+	elements = append(elements, array.Elements[index:]...)
+	array.Elements = elements
+	return NULL
+}
+
+func arrayRemove(array *Array, args ...Object) Object {
+	err := Check(
+		"array.remove", args,
+		ExactArgs(1),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.remove() cannot modify frozen array")
+	}
+
+	index := args[0].(*Integer).Value
+	if index < 0 || index >= int64(len(array.Elements)) {
+		return NewErrorFormat("IndexError: array.remove() index %d out of range of array with length %d", index, len(array.Elements))
+	}
+
+	removed := array.Elements[index]
+	elements := make([]Object, 0, len(array.Elements)-1)
+	elements = append(elements, array.Elements[:index]...)
+	elements = append(elements, array.Elements[index+1:]...)
+	array.Elements = elements
+	return removed
+}
+
+func arrayUnshift(array *Array, args ...Object) Object {
+	err := Check(
+		"array.unshift", args,
+		MinimumArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if array.frozen {
+		return NewErrorFormat("TypeError: array.unshift() cannot modify frozen array")
+	}
+
+	elements := make([]Object, 0, len(array.Elements)+len(args))
+	elements = append(elements, args...)
+	elements = append(elements, array.Elements...)
+	array.Elements = elements
+	return NULL
+}
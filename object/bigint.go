@@ -0,0 +1,105 @@
+package object
+
+import (
+	"hash/fnv"
+	"math/big"
+)
+
+// BigInt is an integer of arbitrary precision, integer arithmetic is promoted
+// to it when result doesn't fit on Integer.
+type BigInt struct {
+	Value *big.Int
+}
+
+// NewInteger create an Integer when value fit on int64, otherwise a BigInt
+func NewInteger(value *big.Int) Object {
+	if value.IsInt64() {
+		return &Integer{Value: value.Int64()}
+	}
+	return &BigInt{Value: value}
+}
+
+// ToBigInt get value of an Integer or BigInt as big.Int
+func ToBigInt(o Object) (*big.Int, bool) {
+	switch o := o.(type) {
+	case *Integer:
+		return big.NewInt(o.Value), true
+	case *BigInt:
+		return o.Value, true
+	}
+	return nil, false
+}
+
+func (b *BigInt) Type() ObjectType { return BIGINT_OBJ }
+func (b *BigInt) Inspect() string  { return b.Value.String() }
+
+func (b *BigInt) HashKey() HashKey {
+	value := b.Value.String()
+	h := fnv.New64a()
+	h.Write([]byte(value))
+	return HashKey{Type: b.Type(), Value: h.Sum64(), Data: value}
+}
+
+func (b *BigInt) Compare(right Object) int8 {
+	value, ok := ToBigInt(right)
+	if !ok {
+		return -1
+	}
+	return int8(b.Value.Cmp(value))
+}
+
+// Float get nearest float of value
+func (b *BigInt) Float() float64 {
+	f, _ := new(big.Float).SetInt(b.Value).Float64()
+	return f
+}
+
+func (b *BigInt) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"bigint.type",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: BIGINT_OBJ}
+	case "string":
+		err := Check(
+			"bigint.string",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: b.Value.String()}
+	case "float":
+		err := Check(
+			"bigint.float",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Float{Value: b.Float()}
+	case "abs":
+		err := Check(
+			"bigint.abs",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return NewInteger(new(big.Int).Abs(b.Value))
+	}
+	return NewErrorFormat("method %s not exists on bigint object.", method)
+}
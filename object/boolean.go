@@ -9,6 +9,13 @@ var (
 	FALSE = &Boolean{Value: false}
 )
 
+func nativeBoolToBooleanObject(input bool) *Boolean {
+	if input {
+		return TRUE
+	}
+	return FALSE
+}
+
 type Boolean struct {
 	Value bool
 }
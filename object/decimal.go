@@ -0,0 +1,443 @@
+package object
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode is how digits are discarded when a Decimal lose scale
+type RoundingMode string
+
+const (
+	ROUND_HALF_UP   RoundingMode = "halfUp"   // nearest, ties away from zero
+	ROUND_HALF_DOWN RoundingMode = "halfDown" // nearest, ties toward zero
+	ROUND_HALF_EVEN RoundingMode = "halfEven" // nearest, ties to even digit
+	ROUND_UP        RoundingMode = "up"       // away from zero
+	ROUND_DOWN      RoundingMode = "down"     // toward zero
+	ROUND_CEILING   RoundingMode = "ceiling"  // toward positive infinity
+	ROUND_FLOOR     RoundingMode = "floor"    // toward negative infinity
+)
+
+var roundingModes = []RoundingMode{
+	ROUND_HALF_UP,
+	ROUND_HALF_DOWN,
+	ROUND_HALF_EVEN,
+	ROUND_UP,
+	ROUND_DOWN,
+	ROUND_CEILING,
+	ROUND_FLOOR,
+}
+
+// DefaultRoundingMode is used when rounding mode isn't given
+var DefaultRoundingMode = ROUND_HALF_UP
+
+// MaxDecimalScale limit scale of decimals and exponent of parsed decimals,
+// greater scales need powers of ten too large to compute
+const MaxDecimalScale = 1000000
+
+// DecimalDivisionScale is minimum scale of a division result, since some
+// divisions have infinite digits, e.g.: 1 / 3
+var DecimalDivisionScale int32 = 16
+
+// ParseRoundingMode get rounding mode by its name
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for _, mode := range roundingModes {
+		if string(mode) == name {
+			return mode, nil
+		}
+	}
+
+	names := make([]string, len(roundingModes))
+	for i, mode := range roundingModes {
+		names[i] = string(mode)
+	}
+	return "", fmt.Errorf("ValueError: unknown rounding mode %s, expected one of %s", name, strings.Join(names, ", "))
+}
+
+// Decimal is an exact decimal number, it is represented by an unscaled
+// integer and a scale, e.g.: 12.30 is 1230 with scale 2.
+type Decimal struct {
+	Value *big.Int
+	Scale int32
+}
+
+// NewDecimal create a decimal from unscaled value and scale
+func NewDecimal(value *big.Int, scale int32) *Decimal {
+	return &Decimal{Value: value, Scale: scale}
+}
+
+// ParseDecimal parse strings like "12.30", "-0.5" or "1.5e3"
+func ParseDecimal(str string) (*Decimal, error) {
+	s := strings.TrimSpace(str)
+
+	exponent := int64(0)
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		exp, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %q as decimal", str)
+		}
+		exponent = exp
+		s = s[:i]
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" || !isDecimalDigits(integer) || !isDecimalDigits(fraction) {
+		return nil, fmt.Errorf("could not parse %q as decimal", str)
+	}
+
+	value, _ := new(big.Int).SetString(sign+integer+fraction, 10)
+
+	scale := int64(len(fraction)) - exponent
+	if scale < -MaxDecimalScale || scale > MaxDecimalScale {
+		return nil, fmt.Errorf("could not parse %q as decimal, scale must be between -%d and %d", str, MaxDecimalScale, MaxDecimalScale)
+	}
+	if scale < 0 {
+		value.Mul(value, pow10(int32(-scale)))
+		scale = 0
+	}
+	return NewDecimal(value, int32(scale)), nil
+}
+
+func isDecimalDigits(str string) bool {
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ToDecimal convert integers, floats and decimals into a Decimal, floats are
+// converted by their shortest representation, e.g.: 0.1 is 0.1
+func ToDecimal(o Object) (*Decimal, bool) {
+	switch o := o.(type) {
+	case *Decimal:
+		return o, true
+	case *Integer:
+		return NewDecimal(big.NewInt(o.Value), 0), true
+	case *BigInt:
+		return NewDecimal(new(big.Int).Set(o.Value), 0), true
+	case *Float:
+		if math.IsNaN(o.Value) || math.IsInf(o.Value, 0) {
+			return nil, false
+		}
+		d, err := ParseDecimal(strconv.FormatFloat(o.Value, 'f', -1, 64))
+		return d, err == nil
+	}
+	return nil, false
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// rescale get unscaled value of d on a greater scale
+func (d *Decimal) rescale(scale int32) *big.Int {
+	if scale == d.Scale {
+		return d.Value
+	}
+	return new(big.Int).Mul(d.Value, pow10(scale-d.Scale))
+}
+
+func (d *Decimal) align(right *Decimal) (*big.Int, *big.Int, int32) {
+	scale := d.Scale
+	if right.Scale > scale {
+		scale = right.Scale
+	}
+	return d.rescale(scale), right.rescale(scale), scale
+}
+
+func (d *Decimal) Add(right *Decimal) *Decimal {
+	l, r, scale := d.align(right)
+	return NewDecimal(new(big.Int).Add(l, r), scale)
+}
+
+func (d *Decimal) Sub(right *Decimal) *Decimal {
+	l, r, scale := d.align(right)
+	return NewDecimal(new(big.Int).Sub(l, r), scale)
+}
+
+// Mul multiply d by right, scale of result is sum of both scales and it
+// can't be greater than MaxDecimalScale
+func (d *Decimal) Mul(right *Decimal) (*Decimal, error) {
+	scale := int64(d.Scale) + int64(right.Scale)
+	if scale > MaxDecimalScale {
+		return nil, fmt.Errorf("ValueError: decimal scale %d is larger than %d", scale, MaxDecimalScale)
+	}
+	return NewDecimal(new(big.Int).Mul(d.Value, right.Value), int32(scale)), nil
+}
+
+// Rem is remainder of d / right, it has the sign of d
+func (d *Decimal) Rem(right *Decimal) *Decimal {
+	l, r, scale := d.align(right)
+	return NewDecimal(new(big.Int).Rem(l, r), scale)
+}
+
+// Pow raise d to a non-negative integer exponent
+func (d *Decimal) Pow(exponent int64) *Decimal {
+	value := new(big.Int).Exp(d.Value, big.NewInt(exponent), nil)
+	return NewDecimal(value, d.Scale*int32(exponent))
+}
+
+// Quo divide d by right with given scale, trailing zeros are removed until
+// scale of greater operand. right must not be zero.
+func (d *Decimal) Quo(right *Decimal, scale int32, mode RoundingMode) *Decimal {
+	numerator := new(big.Int).Set(d.Value)
+	denominator := new(big.Int).Set(right.Value)
+
+	shift := scale - d.Scale + right.Scale
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	result := NewDecimal(roundQuotient(numerator, denominator, mode), scale)
+
+	minimum := d.Scale
+	if right.Scale > minimum {
+		minimum = right.Scale
+	}
+	return result.trim(minimum)
+}
+
+// Round change scale of decimal, when scale is lower digits are discarded
+// using rounding mode.
+func (d *Decimal) Round(scale int32, mode RoundingMode) *Decimal {
+	if scale >= d.Scale {
+		return NewDecimal(d.rescale(scale), scale)
+	}
+	return NewDecimal(roundQuotient(d.Value, pow10(d.Scale-scale), mode), scale)
+}
+
+// trim remove trailing zeros until scale reach minimum
+func (d *Decimal) trim(minimum int32) *Decimal {
+	value := new(big.Int).Set(d.Value)
+	scale := d.Scale
+
+	ten := big.NewInt(10)
+	remainder := new(big.Int)
+	quotient := new(big.Int)
+	for scale > minimum {
+		quotient.QuoRem(value, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		value.Set(quotient)
+		scale--
+	}
+	return NewDecimal(value, scale)
+}
+
+// roundQuotient divide numerator by denominator rounding the result
+func roundQuotient(numerator, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// sign of exact result, quotient is truncated toward zero
+	sign := numerator.Sign() * denominator.Sign()
+
+	half := new(big.Int).Abs(remainder)
+	half.Mul(half, big.NewInt(2))
+	half.Sub(half, new(big.Int).Abs(denominator))
+
+	var awayFromZero bool
+	switch mode {
+	case ROUND_UP:
+		awayFromZero = true
+	case ROUND_DOWN:
+		awayFromZero = false
+	case ROUND_CEILING:
+		awayFromZero = sign > 0
+	case ROUND_FLOOR:
+		awayFromZero = sign < 0
+	case ROUND_HALF_DOWN:
+		awayFromZero = half.Sign() > 0
+	case ROUND_HALF_EVEN:
+		awayFromZero = half.Sign() > 0 || (half.Sign() == 0 && quotient.Bit(0) == 1)
+	default:
+		awayFromZero = half.Sign() >= 0
+	}
+
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+	return quotient
+}
+
+// Integer get integer part of decimal, digits after point are discarded
+func (d *Decimal) Integer() *big.Int {
+	return new(big.Int).Quo(d.Value, pow10(d.Scale))
+}
+
+// Float get nearest float of value
+func (d *Decimal) Float() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d *Decimal) String() string {
+	digits := new(big.Int).Abs(d.Value).String()
+
+	sign := ""
+	if d.Value.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.Scale <= 0 {
+		return sign + digits
+	}
+
+	scale := int(d.Scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+func (d *Decimal) Type() ObjectType { return DECIMAL_OBJ }
+func (d *Decimal) Inspect() string  { return d.String() }
+
+// HashKey is same for equal decimals regardless scale, e.g.: 1.0 and 1.00
+func (d *Decimal) HashKey() HashKey {
+	value := d.trim(0).String()
+	h := fnv.New64a()
+	h.Write([]byte(value))
+	return HashKey{Type: d.Type(), Value: h.Sum64(), Data: value}
+}
+
+func (d *Decimal) Compare(right Object) int8 {
+	value, ok := ToDecimal(right)
+	if !ok {
+		return -1
+	}
+	l, r, _ := d.align(value)
+	return int8(l.Cmp(r))
+}
+
+func (d *Decimal) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"decimal.type",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: DECIMAL_OBJ}
+	case "string":
+		err := Check(
+			"decimal.string",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: d.String()}
+	case "float":
+		err := Check(
+			"decimal.float",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Float{Value: d.Float()}
+	case "int":
+		err := Check(
+			"decimal.int",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return NewInteger(d.Integer())
+	case "abs":
+		err := Check(
+			"decimal.abs",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return NewDecimal(new(big.Int).Abs(d.Value), d.Scale)
+	case "scale":
+		err := Check(
+			"decimal.scale",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Integer{Value: int64(d.Scale)}
+	case "round":
+		err := Check(
+			"decimal.round",
+			args,
+			RangeOfArgs(0, 2),
+			WithTypes(INTEGER_OBJ, STRING_OBJ),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		scale, mode, err := RoundingArguments("decimal.round", args)
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return d.Round(scale, mode)
+	}
+	return NewErrorFormat("method %s not exists on decimal object.", method)
+}
+
+// RoundingArguments read optional scale and rounding mode arguments, e.g.:
+// round(2, "halfEven"), scale defaults to 0.
+func RoundingArguments(name string, args []Object) (int32, RoundingMode, error) {
+	scale := int64(0)
+	if len(args) > 0 {
+		scale = args[0].(*Integer).Value
+	}
+
+	if scale < 0 {
+		return 0, "", fmt.Errorf("ValueError: %s() scale must not be negative got %d", name, scale)
+	}
+
+	if scale > MaxDecimalScale {
+		return 0, "", fmt.Errorf("ValueError: %s() scale %d is larger than %d", name, scale, MaxDecimalScale)
+	}
+
+	mode := DefaultRoundingMode
+	if len(args) > 1 {
+		m, err := ParseRoundingMode(args[1].(*String).Value)
+		if err != nil {
+			return 0, "", err
+		}
+		mode = m
+	}
+	return int32(scale), mode, nil
+}
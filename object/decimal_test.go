@@ -0,0 +1,35 @@
+package object
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToDecimal_CopyBigInt(t *testing.T) {
+	value, _ := new(big.Int).SetString("18446744073709551616", 10)
+	bigInt := &BigInt{Value: value}
+
+	d, ok := ToDecimal(bigInt)
+	if !ok {
+		t.Fatalf("ToDecimal() expected to convert bigint")
+	}
+
+	d.Value.Add(d.Value, big.NewInt(1))
+	if bigInt.Value.String() != "18446744073709551616" {
+		t.Fatalf("ToDecimal() expected to not share value with bigint. Got: %s", bigInt.Value)
+	}
+}
+
+func TestDecimal_MulScaleLimit(t *testing.T) {
+	left := NewDecimal(big.NewInt(1), MaxDecimalScale)
+	right := NewDecimal(big.NewInt(1), 1)
+
+	if _, err := left.Mul(right); err == nil {
+		t.Fatalf("Decimal.Mul() expected error when scale is larger than MaxDecimalScale")
+	}
+
+	left = NewDecimal(big.NewInt(1), 2147483647)
+	if _, err := left.Mul(left); err == nil {
+		t.Fatalf("Decimal.Mul() expected error when scale overflow int32")
+	}
+}
@@ -3,25 +3,343 @@ package object
 import "strings"
 
 type Enum struct {
-	Branches map[string]Object
+	Name     string
+	Branches map[string]Object // constant value of each case, NULL when case don't declare one
+	Cases    []*EnumValue      // all cases by declaration order
+	Methods  map[string]*FunctionLiteral
 }
 
 func (en *Enum) Type() ObjectType { return ENUM_OBJ }
 func (en *Enum) Inspect() string {
 	out := strings.Builder{}
 	out.WriteString("{")
-	str := make([]string, len(en.Branches))
-	i := 0
-	for s, v := range en.Branches {
+	str := make([]string, 0, len(en.Branches))
+	for _, name := range en.caseNames() {
 		outBranches := strings.Builder{}
 		outBranches.WriteString("case ")
-		outBranches.WriteString(s)
-		outBranches.WriteString(" : ")
-		outBranches.WriteString(v.Inspect())
-		str[i] = outBranches.String()
-		i++
+		outBranches.WriteString(name)
+		if c, ok := en.Case(name); ok && c.Fields != nil {
+			outBranches.WriteString("(")
+			outBranches.WriteString(strings.Join(c.Fields, ", "))
+			outBranches.WriteString(")")
+		}
+		if v, ok := en.Branches[name]; ok && v != NULL {
+			outBranches.WriteString(" : ")
+			outBranches.WriteString(v.Inspect())
+		}
+		str = append(str, outBranches.String())
 	}
 	out.WriteString(strings.Join(str, "; "))
 	out.WriteString("}")
 	return out.String()
 }
+
+// caseNames get names of cases by declaration order, when cases are unknown
+// names are taken from branches.
+func (en *Enum) caseNames() []string {
+	names := make([]string, 0, len(en.Branches))
+	if len(en.Cases) > 0 {
+		for _, c := range en.Cases {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	for name := range en.Branches {
+		names = append(names, name)
+	}
+	return names
+}
+
+// AddCase register a new case on enum, it keep declaration order for ordinal.
+func (en *Enum) AddCase(name string, value Object, fields []string) *EnumValue {
+	if value == nil {
+		value = NULL
+	}
+
+	c := &EnumValue{Enum: en, Name: name, Ordinal: len(en.Cases), Value: value, Fields: fields}
+	en.Cases = append(en.Cases, c)
+	en.Branches[name] = value
+	return c
+}
+
+// Case find case by it is name
+func (en *Enum) Case(name string) (*EnumValue, bool) {
+	for _, c := range en.Cases {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func (en *Enum) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"enum.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &String{Value: ENUM_OBJ}
+	case "name":
+		err := Check(
+			"enum.name", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &String{Value: en.Name}
+	case "cases":
+		err := Check(
+			"enum.cases", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		elements := make([]Object, len(en.Cases))
+		for i, c := range en.Cases {
+			elements[i] = c
+		}
+		return &Array{Elements: elements}
+	case "from":
+		return enumFrom(en, args...)
+	case "tryFrom":
+		return enumTryFrom(en, args...)
+	}
+	return NewErrorFormat("method %s not exists on enum object.", method)
+}
+
+// lookup search case which hold value
+func (en *Enum) lookup(value Object) (*EnumValue, bool) {
+	for _, c := range en.Cases {
+		if c.Value == NULL || c.Value.Type() != value.Type() {
+			continue
+		}
+
+		if c.Value.Inspect() == value.Inspect() {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func enumFrom(en *Enum, args ...Object) Object {
+	err := Check(
+		"enum.from", args,
+		ExactArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	c, ok := en.lookup(args[0])
+	if !ok {
+		return NewErrorFormat("ValueError: %s is not a valid value for enum %s", args[0].Inspect(), en.Name)
+	}
+	return c
+}
+
+func enumTryFrom(en *Enum, args ...Object) Object {
+	err := Check(
+		"enum.tryFrom", args,
+		ExactArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	c, ok := en.lookup(args[0])
+	if !ok {
+		return NULL
+	}
+	return c
+}
+
+// EnumValue is a case of an enum, cases with associated data are constructed
+// by calling case with it is payload, e.g.: Shape::Circle(10)
+type EnumValue struct {
+	Enum    *Enum
+	Name    string
+	Ordinal int
+	Value   Object   // constant value declared on case or NULL
+	Fields  []string // parameters name of associated data
+	Payload []Object
+}
+
+func (ev *EnumValue) Type() ObjectType { return ENUM_VALUE_OBJ }
+func (ev *EnumValue) Inspect() string {
+	out := strings.Builder{}
+	out.WriteString(ev.Enum.Name)
+	out.WriteString("::")
+	out.WriteString(ev.Name)
+	if ev.Payload != nil {
+		payload := make([]string, len(ev.Payload))
+		for i, p := range ev.Payload {
+			payload[i] = p.Inspect()
+		}
+		out.WriteString("(")
+		out.WriteString(strings.Join(payload, ", "))
+		out.WriteString(")")
+	}
+	return out.String()
+}
+
+// IsConstructor tell if case requires associated data to be constructed
+func (ev *EnumValue) IsConstructor() bool {
+	return ev.Fields != nil && ev.Payload == nil
+}
+
+// Construct create a new value of case with associated data
+func (ev *EnumValue) Construct(args ...Object) Object {
+	err := Check(
+		ev.Enum.Name+"::"+ev.Name, args,
+		ExactArgs(len(ev.Fields)),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	payload := make([]Object, len(args))
+	copy(payload, args)
+
+	return &EnumValue{
+		Enum:    ev.Enum,
+		Name:    ev.Name,
+		Ordinal: ev.Ordinal,
+		Value:   ev.Value,
+		Fields:  ev.Fields,
+		Payload: payload,
+	}
+}
+
+// Compare cases by order they were declared, cases from different enums
+// aren't comparable.
+func (ev *EnumValue) Compare(right Object) int8 {
+	obj, ok := right.(*EnumValue)
+	if !ok || obj.Enum != ev.Enum {
+		return -1
+	}
+
+	switch {
+	case ev.Ordinal < obj.Ordinal:
+		return -1
+	case ev.Ordinal > obj.Ordinal:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Equal check if both are same case and hold same associated data
+func (ev *EnumValue) Equal(right Object) bool {
+	obj, ok := right.(*EnumValue)
+	if !ok || obj.Enum != ev.Enum || obj.Name != ev.Name {
+		return false
+	}
+
+	if len(ev.Payload) != len(obj.Payload) {
+		return false
+	}
+
+	for i, p := range ev.Payload {
+		if p.Type() != obj.Payload[i].Type() || p.Inspect() != obj.Payload[i].Inspect() {
+			return false
+		}
+	}
+	return true
+}
+
+func (ev *EnumValue) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"enum.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &String{Value: ev.Enum.Name}
+	case "name":
+		err := Check(
+			"enum.name", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &String{Value: ev.Name}
+	case "ordinal":
+		err := Check(
+			"enum.ordinal", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &Integer{Value: int64(ev.Ordinal)}
+	case "value":
+		err := Check(
+			"enum.value", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return ev.Value
+	case "payload":
+		err := Check(
+			"enum.payload", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		elements := make([]Object, len(ev.Payload))
+		copy(elements, ev.Payload)
+		return &Array{Elements: elements}
+	}
+
+	for i, field := range ev.Fields {
+		if field != method || i >= len(ev.Payload) {
+			continue
+		}
+
+		err := Check(
+			"enum."+field, args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return ev.Payload[i]
+	}
+
+	return NewErrorFormat("method %s not exists on enum %s object.", method, ev.Enum.Name)
+}
@@ -37,3 +37,42 @@ func TestEnum_Type(t *testing.T) {
 		t.Errorf("enum.type() expected to be %s. Got: %s", ENUM_OBJ, enum.Type())
 	}
 }
+
+func TestEnum_Cases(t *testing.T) {
+	enum := &Enum{Name: "STATUS", Branches: map[string]Object{}}
+	ok := enum.AddCase("OK", &Integer{Value: 200}, nil)
+	circle := enum.AddCase("Circle", nil, []string{"radius"})
+
+	if enum.Inspect() != "{case OK : 200; case Circle(radius)}" {
+		t.Errorf("enum.inspect() expected to be ordered. Got: %s", enum.Inspect())
+	}
+
+	if ok.Ordinal != 0 || circle.Ordinal != 1 {
+		t.Errorf("enum cases ordinal expected to follow declaration. Got: %d, %d", ok.Ordinal, circle.Ordinal)
+	}
+
+	if ok.Compare(circle) != -1 || circle.Compare(ok) != 1 || ok.Compare(ok) != 0 {
+		t.Errorf("enum cases expected to be compared by ordinal")
+	}
+
+	if !circle.IsConstructor() {
+		t.Fatalf("enum case with fields expected to be a constructor")
+	}
+
+	value := circle.Construct(&Integer{Value: 10})
+	if value.Inspect() != "STATUS::Circle(10)" {
+		t.Errorf("enum value inspect expected to be %s. Got: %s", "STATUS::Circle(10)", value.Inspect())
+	}
+
+	if value.Type() != ENUM_VALUE_OBJ {
+		t.Errorf("enum value type expected to be %s. Got: %s", ENUM_VALUE_OBJ, value.Type())
+	}
+
+	if !circle.Construct(&Integer{Value: 10}).(*EnumValue).Equal(value) {
+		t.Errorf("enum values with same payload expected to be equal")
+	}
+
+	if ok.Inspect() != "STATUS::OK" {
+		t.Errorf("enum value inspect expected to be %s. Got: %s", "STATUS::OK", ok.Inspect())
+	}
+}
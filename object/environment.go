@@ -1,9 +1,11 @@
 package object
 
 type Environment struct {
-	isGlobal bool
-	store    map[string]Object
-	outer    *Environment
+	isGlobal  bool
+	store     map[string]Object
+	constants map[string]bool
+	outer     *Environment
+	file      string // file being evaluated on this environment, if any
 }
 
 var GlobalEnvironment = NewGlobalEnvironment()
@@ -23,7 +25,8 @@ func NewGlobalEnvironment() *Environment {
 
 func NewEnvironment() *Environment {
 	s := make(map[string]Object)
-	return &Environment{isGlobal: false, store: s, outer: nil}
+	c := make(map[string]bool)
+	return &Environment{isGlobal: false, store: s, constants: c, outer: nil}
 }
 
 func (e *Environment) Get(name string) (Object, bool) {
@@ -39,6 +42,45 @@ func (e *Environment) Set(name string, val Object) Object {
 	return val
 }
 
+// SetConstant bind name to val and mark it as constant, which can't be reassigned
+func (e *Environment) SetConstant(name string, val Object) Object {
+	e.constants[name] = true
+	return e.Set(name, val)
+}
+
+// IsConstant check if name resolve to a constant, looking at nearest environment
+// where name is declared.
+func (e *Environment) IsConstant(name string) bool {
+	if _, ok := e.store[name]; ok {
+		return e.constants[name]
+	}
+
+	if e.outer != nil {
+		return e.outer.IsConstant(name)
+	}
+
+	return false
+}
+
+// SetFile tell which file is evaluated on this environment
+func (e *Environment) SetFile(filename string) {
+	e.file = filename
+}
+
+// File get file being evaluated, looking at enclosing environments until one
+// know it, it is empty when code doesn't come from a file, e.g.: repl.
+func (e *Environment) File() string {
+	if e.file != "" || e.outer == nil {
+		return e.file
+	}
+	return e.outer.File()
+}
+
+// IsLocalConstant check if name is a constant declared on current environment only
+func (e *Environment) IsLocalConstant(name string) bool {
+	return e.constants[name]
+}
+
 /*
 func (e *Environment) Set(name string, val Object) Object {
 	_, ok := e.store[name]
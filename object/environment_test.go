@@ -62,3 +62,22 @@ func TestNewEnclosedEnvironment_GetInner(t *testing.T) {
 		t.Fatalf("env.Get('name') expected to be %s. Got: %s", "Ninja", stringLiteral.Value)
 	}
 }
+
+func TestEnvironment_File(t *testing.T) {
+	env := NewEnvironment()
+	if env.File() != "" {
+		t.Fatalf("env.File() expected to be empty. Got: %s", env.File())
+	}
+
+	env.SetFile("lib/main.nj")
+	innerEnv := NewEnclosedEnvironment(NewEnclosedEnvironment(env))
+
+	if innerEnv.File() != "lib/main.nj" {
+		t.Errorf("innerEnv.File() expected to be %s. Got: %s", "lib/main.nj", innerEnv.File())
+	}
+
+	innerEnv.SetFile("lib/other.nj")
+	if env.File() != "lib/main.nj" {
+		t.Errorf("env.File() expected to be %s. Got: %s", "lib/main.nj", env.File())
+	}
+}
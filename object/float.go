@@ -2,7 +2,6 @@ package object
 
 import (
 	"fmt"
-	"hash/fnv"
 	"math"
 	"strconv"
 )
@@ -11,22 +10,19 @@ import (
 var EPSILON float64 = 0.00000001
 
 type Float struct {
-	Value        float64
-	hashKeyCache uint64
+	Value float64
 }
 
 func (f *Float) Inspect() string  { return fmt.Sprintf("%f", f.Value) }
 func (f *Float) Type() ObjectType { return FLOAT_OBJ }
 
+// HashKey is bits of float, so 0.1234567 and 0.1234568 are different keys
 func (f *Float) HashKey() HashKey {
-
-	if f.hashKeyCache <= 0 {
-		h := fnv.New64a()
-		h.Write([]byte(fmt.Sprintf("%f", f.Value)))
-		f.hashKeyCache = h.Sum64()
+	// 0.0 and -0.0 are equal but have different bits
+	if f.Value == 0 {
+		return HashKey{Type: f.Type(), Value: 0}
 	}
-
-	return HashKey{Type: f.Type(), Value: f.hashKeyCache}
+	return HashKey{Type: f.Type(), Value: math.Float64bits(f.Value)}
 }
 
 func (f *Float) Compare(right Object) int8 {
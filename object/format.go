@@ -0,0 +1,174 @@
+package object
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Sprintf format arguments according to format, it follow Go verbs with flags,
+// width and precision (e.g.: "%-8.2f") plus %v which use Inspect() and %j which
+// render value as JSON. Name is used on error messages.
+func Sprintf(name string, format string, args []Object) (string, error) {
+	out := strings.Builder{}
+	next := 0
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' {
+			out.WriteRune(runes[i])
+			continue
+		}
+
+		spec := strings.Builder{}
+		spec.WriteRune('%')
+		i++
+		for i < len(runes) && strings.ContainsRune("-+# 0", runes[i]) {
+			spec.WriteRune(runes[i])
+			i++
+		}
+
+		width, end := formatNumber(runes, i)
+		i = end
+		if width != "" {
+			if err := checkFormatSize(name, "width", width); err != nil {
+				return "", err
+			}
+			spec.WriteString(width)
+		}
+
+		if i < len(runes) && runes[i] == '.' {
+			precision, end := formatNumber(runes, i+1)
+			i = end
+			if err := checkFormatSize(name, "precision", precision); err != nil {
+				return "", err
+			}
+			spec.WriteString(".")
+			spec.WriteString(precision)
+		}
+
+		if i < len(runes) && runes[i] == '.' {
+			return "", fmt.Errorf("ValueError: %s() malformed verb %s.", name, spec.String())
+		}
+
+		if i >= len(runes) {
+			return "", fmt.Errorf("ValueError: %s() format end with incomplete verb %s", name, spec.String())
+		}
+
+		verb := runes[i]
+		if verb == '%' {
+			out.WriteRune('%')
+			continue
+		}
+
+		if next >= len(args) {
+			return "", fmt.Errorf("TypeError: %s() missing argument for verb %%%c", name, verb)
+		}
+
+		formatted, err := formatVerb(name, spec.String(), verb, next+2, args[next])
+		if err != nil {
+			return "", err
+		}
+		out.WriteString(formatted)
+		next++
+	}
+
+	if next < len(args) {
+		return "", fmt.Errorf("TypeError: %s() format use %d arguments but %d were given", name, next, len(args))
+	}
+	return out.String(), nil
+}
+
+// MaxFormatSize is largest width or precision accepted by Sprintf
+const MaxFormatSize = 1024
+
+// formatNumber read digits starting at position, it give digits and position
+// after them
+func formatNumber(runes []rune, position int) (string, int) {
+	start := position
+	for position < len(runes) && runes[position] >= '0' && runes[position] <= '9' {
+		position++
+	}
+	return string(runes[start:position]), position
+}
+
+func checkFormatSize(name string, kind string, digits string) error {
+	if digits == "" {
+		return nil
+	}
+
+	size, err := strconv.Atoi(digits)
+	if err != nil || size > MaxFormatSize {
+		return fmt.Errorf("ValueError: %s() %s %s is larger than %d", name, kind, digits, MaxFormatSize)
+	}
+	return nil
+}
+
+// formatVerb format a single argument, position is it is position on call
+// counting with format itself
+func formatVerb(name string, spec string, verb rune, position int, arg Object) (string, error) {
+	// functions without return give nil, it is formatted as null like %j does
+	if arg == nil {
+		arg = NULL
+	}
+
+	mismatch := func(expected string) error {
+		return fmt.Errorf("TypeError: %s() verb %%%c expected argument #%d to be `%s` got `%s`", name, verb, position, expected, arg.Type())
+	}
+
+	switch verb {
+	case 'v':
+		return fmt.Sprintf(spec+"s", arg.Inspect()), nil
+	case 'T':
+		return fmt.Sprintf(spec+"s", arg.Type()), nil
+	case 's', 'q':
+		if str, ok := arg.(*String); ok {
+			return fmt.Sprintf(spec+string(verb), str.Value), nil
+		}
+		return fmt.Sprintf(spec+string(verb), arg.Inspect()), nil
+	case 'j':
+		value, err := EncodeJSON(name, arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"s", value), nil
+	case 't':
+		boolean, ok := arg.(*Boolean)
+		if !ok {
+			return "", mismatch(BOOLEAN_OBJ)
+		}
+		return fmt.Sprintf(spec+"t", boolean.Value), nil
+	case 'c':
+		integer, ok := arg.(*Integer)
+		if !ok {
+			return "", mismatch(INTEGER_OBJ)
+		}
+		return fmt.Sprintf(spec+"c", rune(integer.Value)), nil
+	case 'd', 'b', 'o', 'x', 'X':
+		switch arg := arg.(type) {
+		case *Integer:
+			return fmt.Sprintf(spec+string(verb), arg.Value), nil
+		case *BigInt:
+			return fmt.Sprintf(spec+string(verb), arg.Value), nil
+		case *String:
+			if verb == 'x' || verb == 'X' {
+				return fmt.Sprintf(spec+string(verb), arg.Value), nil
+			}
+		}
+		return "", mismatch(INTEGER_OBJ)
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		switch arg := arg.(type) {
+		case *Integer:
+			return fmt.Sprintf(spec+string(verb), float64(arg.Value)), nil
+		case *Float:
+			return fmt.Sprintf(spec+string(verb), arg.Value), nil
+		case *BigInt:
+			return fmt.Sprintf(spec+string(verb), new(big.Float).SetInt(arg.Value)), nil
+		case *Decimal:
+			value, _, _ := big.ParseFloat(arg.String(), 10, 256, big.ToNearestEven)
+			return fmt.Sprintf(spec+string(verb), value), nil
+		}
+		return "", mismatch("number")
+	}
+	return "", fmt.Errorf("ValueError: %s() unknown verb %%%c", name, verb)
+}
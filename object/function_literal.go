@@ -10,6 +10,7 @@ type FunctionLiteral struct {
 	Parameters []ast.Expression
 	Body       *ast.BlockStatement
 	Env        *Environment
+	ReturnType *ast.TypeAnnotation
 }
 
 func (f *FunctionLiteral) Type() ObjectType { return FUNCTION_OBJ }
@@ -0,0 +1,65 @@
+package object
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const zeroWidthJoiner = '\u200d'
+
+// Graphemes split string into user-perceived characters. It follows the main
+// rules of extended grapheme clusters: combining marks, variation selectors,
+// emoji modifiers and tags stay with their base, emoji joined by a zero width
+// joiner are kept together, as pairs of regional indicators (flags) and CRLF.
+func Graphemes(str string) []string {
+	graphemes := make([]string, 0, utf8.RuneCountInString(str))
+
+	start := 0
+	var previous rune = -1
+	regionalIndicators := 0
+	for i, r := range str {
+		if previous != -1 && !continuesGrapheme(previous, r, regionalIndicators) {
+			graphemes = append(graphemes, str[start:i])
+			start = i
+			regionalIndicators = 0
+		}
+
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		}
+		previous = r
+	}
+
+	if start < len(str) {
+		graphemes = append(graphemes, str[start:])
+	}
+	return graphemes
+}
+
+// continuesGrapheme tell if r belong to same grapheme of previous rune
+func continuesGrapheme(previous rune, r rune, regionalIndicators int) bool {
+	switch {
+	case previous == '\r':
+		return r == '\n'
+	case previous == '\n' || r == '\r' || r == '\n':
+		return false
+	case isExtend(r):
+		return true
+	case previous == zeroWidthJoiner:
+		return true
+	case isRegionalIndicator(previous) && isRegionalIndicator(r):
+		return regionalIndicators%2 == 1
+	}
+	return false
+}
+
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == zeroWidthJoiner ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // emoji skin tone modifiers
+		(r >= 0xE0020 && r <= 0xE007F) // tags used on flags of subdivisions
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
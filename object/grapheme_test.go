@@ -0,0 +1,37 @@
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"abc", []string{"a", "b", "c"}},
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"e\u0301\u0327x", []string{"e\u0301\u0327", "x"}},
+		{"\u2764\ufe0f!", []string{"\u2764\ufe0f", "!"}},
+		{"\U0001F1F5\U0001F1F9\U0001F1E7", []string{"\U0001F1F5\U0001F1F9", "\U0001F1E7"}},
+		{"\U0001F469\u200d\U0001F4BB.", []string{"\U0001F469\u200d\U0001F4BB", "."}},
+		{"\u0301a", []string{"\u0301", "a"}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestGraphemes[%d]", i), func(t *testing.T) {
+			graphemes := Graphemes(tt.input)
+			if len(graphemes) != len(tt.expected) {
+				t.Fatalf("Graphemes(%q) expected %q. Got: %q", tt.input, tt.expected, graphemes)
+			}
+
+			for j, g := range graphemes {
+				if g != tt.expected[j] {
+					t.Errorf("Graphemes(%q)[%d] expected %q. Got: %q", tt.input, j, tt.expected[j], g)
+				}
+			}
+		})
+	}
+}
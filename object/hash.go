@@ -11,8 +11,84 @@ type HashPair struct {
 	Value Object
 }
 
+// Hash keep pairs by order they were inserted, Pairs give O(1) lookup and it
+// must only be changed through Set and Delete.
 type Hash struct {
-	Pairs map[HashKey]HashPair
+	Pairs     map[HashKey]HashPair
+	keys      []HashKey       // insertion order, it may have stale keys
+	positions map[HashKey]int // position of each live key on keys
+	frozen    bool
+}
+
+// NewHash create an empty hash
+func NewHash() *Hash {
+	return &Hash{
+		Pairs:     make(map[HashKey]HashPair),
+		positions: make(map[HashKey]int),
+	}
+}
+
+// Set insert or replace pair, a replaced key keep its original position.
+// Composite keys are stored as frozen copies.
+func (h *Hash) Set(key HashKey, pair HashPair) {
+	pair.Key = frozenKey(pair.Key)
+
+	if h.Pairs == nil {
+		h.Pairs = make(map[HashKey]HashPair)
+	}
+
+	if h.positions == nil {
+		h.positions = make(map[HashKey]int)
+	}
+
+	if _, ok := h.positions[key]; !ok {
+		h.positions[key] = len(h.keys)
+		h.keys = append(h.keys, key)
+	}
+	h.Pairs[key] = pair
+}
+
+// Get pair by it is key
+func (h *Hash) Get(key HashKey) (HashPair, bool) {
+	pair, ok := h.Pairs[key]
+	return pair, ok
+}
+
+// Delete remove pair, stale keys are only compacted when they are majority,
+// so deleting is O(1) amortized.
+func (h *Hash) Delete(key HashKey) {
+	if _, ok := h.positions[key]; !ok {
+		return
+	}
+
+	delete(h.Pairs, key)
+	delete(h.positions, key)
+
+	if len(h.keys) > 2*len(h.positions) {
+		h.compact()
+	}
+}
+
+func (h *Hash) compact() {
+	keys := make([]HashKey, 0, len(h.positions))
+	for i, key := range h.keys {
+		if position, ok := h.positions[key]; ok && position == i {
+			h.positions[key] = len(keys)
+			keys = append(keys, key)
+		}
+	}
+	h.keys = keys
+}
+
+// OrderedPairs get pairs by order they were inserted
+func (h *Hash) OrderedPairs() []HashPair {
+	pairs := make([]HashPair, 0, len(h.positions))
+	for i, key := range h.keys {
+		if position, ok := h.positions[key]; ok && position == i {
+			pairs = append(pairs, h.Pairs[key])
+		}
+	}
+	return pairs
 }
 
 func (h *Hash) Type() ObjectType { return HASH_OBJ }
@@ -20,7 +96,7 @@ func (h *Hash) Inspect() string {
 	var out bytes.Buffer
 
 	pairs := []string{}
-	for _, pair := range h.Pairs {
+	for _, pair := range h.OrderedPairs() {
 		pairs = append(pairs, fmt.Sprintf("%s: %s",
 			pair.Key.Inspect(), pair.Value.Inspect()))
 	}
@@ -32,6 +108,32 @@ func (h *Hash) Inspect() string {
 	return out.String()
 }
 
+// Freeze make hash and all of it is values read-only
+func (s *Hash) Freeze() {
+	if s.frozen {
+		return
+	}
+	s.frozen = true
+	for _, pair := range s.Pairs {
+		Freeze(pair.Value)
+	}
+}
+
+func (s *Hash) IsFrozen() bool { return s.frozen }
+
+// Clone copy hash, values which are cloneable are copied too
+func (s *Hash) Clone() Object {
+	hash := NewHash()
+	for _, pair := range s.OrderedPairs() {
+		if cloneable, ok := pair.Value.(Cloneable); ok {
+			pair.Value = cloneable.Clone()
+		}
+		key, _ := HashKeyOf(pair.Key)
+		hash.Set(key, pair)
+	}
+	return hash
+}
+
 func (s *Hash) Call(method string, args ...Object) Object {
 	switch method {
 	case "type":
@@ -46,19 +148,67 @@ func (s *Hash) Call(method string, args ...Object) Object {
 		}
 
 		return &String{Value: HASH_OBJ}
+	case "length":
+		err := Check(
+			"hash.length",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &Integer{Value: int64(len(s.Pairs))}
 	case "keys":
-		return hashKeys(s.Pairs, args...)
+		return hashKeys(s, args...)
 	case "values":
-		return hashValues(s.Pairs, args...)
+		return hashValues(s, args...)
 	case "has":
-		return hashHas(s.Pairs, args...)
+		return hashHas(s, args...)
+	case "get":
+		return hashGet(s, args...)
+	case "set":
+		return hashSet(s, args...)
+	case "remove":
+		return hashRemove(s, args...)
+	case "entries":
+		return hashEntries(s, args...)
+	case "fromEntries":
+		return hashFromEntries(s, args...)
+	case "map":
+		return hashMap(s, args...)
+	case "filter":
+		return hashFilter(s, args...)
+	case "reduce":
+		return hashReduce(s, args...)
 	case "merge":
-		return hashMerge(s.Pairs, args...)
+		return hashMerge(s, args...)
+	case "deepMerge":
+		return hashDeepMerge(s, args...)
+	case "pick":
+		return hashPick(s, false, args...)
+	case "omit":
+		return hashPick(s, true, args...)
+	case "invert":
+		return hashInvert(s, args...)
+	case "clone":
+		err := Check(
+			"hash.clone",
+			args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return s.Clone()
 	}
-	return NewErrorFormat("method %s not exists on string object.", method)
+	return NewErrorFormat("method %s not exists on hash object.", method)
 }
 
-func hashKeys(keys map[HashKey]HashPair, args ...Object) Object {
+func hashKeys(hash *Hash, args ...Object) Object {
 	err := Check(
 		"hash.keys",
 		args,
@@ -69,17 +219,16 @@ func hashKeys(keys map[HashKey]HashPair, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
-	elements := make([]Object, len(keys))
-	i := 0
-	for _, pair := range keys {
+	pairs := hash.OrderedPairs()
+	elements := make([]Object, len(pairs))
+	for i, pair := range pairs {
 		elements[i] = pair.Key
-		i++
 	}
 
 	return &Array{Elements: elements}
 }
 
-func hashValues(keys map[HashKey]HashPair, args ...Object) Object {
+func hashValues(hash *Hash, args ...Object) Object {
 	err := Check(
 		"hash.values",
 		args,
@@ -90,17 +239,18 @@ func hashValues(keys map[HashKey]HashPair, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
-	elements := make([]Object, len(keys))
-	i := 0
-	for _, pair := range keys {
+	pairs := hash.OrderedPairs()
+	elements := make([]Object, len(pairs))
+	for i, pair := range pairs {
 		elements[i] = pair.Value
-		i++
 	}
 
 	return &Array{Elements: elements}
 }
 
-func hashMerge(pairs map[HashKey]HashPair, args ...Object) Object {
+// hashMerge create a new hash with pairs of both hashes, argument pairs
+// replace receiver pairs with same key.
+func hashMerge(hash *Hash, args ...Object) Object {
 	err := Check(
 		"hash.merge",
 		args,
@@ -112,16 +262,20 @@ func hashMerge(pairs map[HashKey]HashPair, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
-	hashPairArg, _ := args[0].(*Hash)
+	other, _ := args[0].(*Hash)
 
-	for k, v := range pairs {
-		hashPairArg.Pairs[k] = v
+	result := NewHash()
+	for _, pairs := range [][]HashPair{hash.OrderedPairs(), other.OrderedPairs()} {
+		for _, pair := range pairs {
+			key, _ := HashKeyOf(pair.Key)
+			result.Set(key, pair)
+		}
 	}
 
-	return hashPairArg
+	return result
 }
 
-func hashHas(keys map[HashKey]HashPair, args ...Object) Object {
+func hashHas(hash *Hash, args ...Object) Object {
 	err := Check(
 		"hash.has",
 		args,
@@ -132,14 +286,251 @@ func hashHas(keys map[HashKey]HashPair, args ...Object) Object {
 		return NewError(err.Error())
 	}
 
-	hashable, ok := args[0].(Hashable)
+	key, ok := HashKeyOf(args[0])
 	if !ok {
 		return NewErrorFormat("hash.has() first argument isnt hashable. got: %s", InspectObject(args...))
 	}
 
-	_, ok = keys[hashable.HashKey()]
+	_, ok = hash.Get(key)
 	if ok {
 		return TRUE
 	}
 	return FALSE
 }
+
+// hashKeyArgument get key of argument used as hash key
+func hashKeyArgument(name string, arg Object) (HashKey, *Error) {
+	key, ok := HashKeyOf(arg)
+	if !ok {
+		return HashKey{}, NewErrorFormat("TypeError: %s() unusable as hash key: %s", name, arg.Type())
+	}
+	return key, nil
+}
+
+func hashGet(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.get",
+		args,
+		RangeOfArgs(1, 2),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	key, keyErr := hashKeyArgument("hash.get", args[0])
+	if keyErr != nil {
+		return keyErr
+	}
+
+	if pair, ok := hash.Get(key); ok {
+		return pair.Value
+	}
+
+	if len(args) == 2 {
+		return args[1]
+	}
+	return NULL
+}
+
+func hashSet(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.set",
+		args,
+		ExactArgs(2),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if hash.frozen {
+		return NewErrorFormat("TypeError: hash.set() cannot modify frozen hash")
+	}
+
+	key, keyErr := hashKeyArgument("hash.set", args[0])
+	if keyErr != nil {
+		return keyErr
+	}
+
+	hash.Set(key, HashPair{Key: args[0], Value: args[1]})
+	return hash
+}
+
+func hashRemove(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.remove",
+		args,
+		ExactArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if hash.frozen {
+		return NewErrorFormat("TypeError: hash.remove() cannot modify frozen hash")
+	}
+
+	key, keyErr := hashKeyArgument("hash.remove", args[0])
+	if keyErr != nil {
+		return keyErr
+	}
+
+	pair, ok := hash.Get(key)
+	if !ok {
+		return NULL
+	}
+
+	hash.Delete(key)
+	return pair.Value
+}
+
+func hashEntries(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.entries",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	pairs := hash.OrderedPairs()
+	elements := make([]Object, len(pairs))
+	for i, pair := range pairs {
+		elements[i] = &Array{Elements: []Object{pair.Key, pair.Value}}
+	}
+
+	return &Array{Elements: elements}
+}
+
+// hashFromEntries create a new hash with receiver pairs and [key, value] entries,
+// e.g.: {}.fromEntries([["a", 1]])
+func hashFromEntries(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.fromEntries",
+		args,
+		ExactArgs(1),
+		WithTypes(ARRAY_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	result := hash.Clone().(*Hash)
+	for i, entry := range args[0].(*Array).Elements {
+		pair, ok := entry.(*Array)
+		if !ok || len(pair.Elements) != 2 {
+			return NewErrorFormat("TypeError: hash.fromEntries() expected entry #%d to be [key, value] got %s", i+1, entry.Inspect())
+		}
+
+		key, keyErr := hashKeyArgument("hash.fromEntries", pair.Elements[0])
+		if keyErr != nil {
+			return keyErr
+		}
+
+		result.Set(key, HashPair{Key: pair.Elements[0], Value: pair.Elements[1]})
+	}
+
+	return result
+}
+
+// hashDeepMerge create a new hash with pairs of both hashes, when both values
+// of a key are hashes they are merged too.
+func hashDeepMerge(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.deepMerge",
+		args,
+		ExactArgs(1),
+		WithTypes(HASH_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return deepMerge(hash, args[0].(*Hash))
+}
+
+func deepMerge(left, right *Hash) *Hash {
+	result := NewHash()
+	for _, pair := range left.OrderedPairs() {
+		key, _ := HashKeyOf(pair.Key)
+		result.Set(key, pair)
+	}
+
+	for _, pair := range right.OrderedPairs() {
+		key, _ := HashKeyOf(pair.Key)
+		current, ok := result.Get(key)
+		if ok {
+			l, okl := current.Value.(*Hash)
+			r, okr := pair.Value.(*Hash)
+			if okl && okr {
+				pair.Value = deepMerge(l, r)
+			}
+		}
+		result.Set(key, pair)
+	}
+	return result
+}
+
+// hashPick create a new hash only with given keys, or without them when omit
+func hashPick(hash *Hash, omit bool, args ...Object) Object {
+	name := "hash.pick"
+	if omit {
+		name = "hash.omit"
+	}
+
+	err := Check(
+		name,
+		args,
+		MinimumArgs(1),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	keys := map[HashKey]bool{}
+	for _, arg := range args {
+		key, keyErr := hashKeyArgument(name, arg)
+		if keyErr != nil {
+			return keyErr
+		}
+		keys[key] = true
+	}
+
+	result := NewHash()
+	for _, pair := range hash.OrderedPairs() {
+		key, _ := HashKeyOf(pair.Key)
+		if keys[key] != omit {
+			result.Set(key, pair)
+		}
+	}
+	return result
+}
+
+func hashInvert(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.invert",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	result := NewHash()
+	for _, pair := range hash.OrderedPairs() {
+		key, keyErr := hashKeyArgument("hash.invert", pair.Value)
+		if keyErr != nil {
+			return keyErr
+		}
+		result.Set(key, HashPair{Key: pair.Value, Value: pair.Key})
+	}
+	return result
+}
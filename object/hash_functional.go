@@ -0,0 +1,87 @@
+package object
+
+// hashMap call fn(value, key) for each pair, value come before key as element
+// come before index on arrays, so function(value) {...} is enough when key
+// isn't needed. Same apply to filter and reduce.
+func hashMap(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.map", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	result := NewHash()
+	for _, pair := range hash.OrderedPairs() {
+		value := applyCallback(args[0], 1, pair.Value, pair.Key)
+		if IsError(value) {
+			return value
+		}
+
+		key, _ := HashKeyOf(pair.Key)
+		result.Set(key, HashPair{Key: pair.Key, Value: value})
+	}
+	return result
+}
+
+func hashFilter(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.filter", args,
+		ExactArgs(1),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	result := NewHash()
+	for _, pair := range hash.OrderedPairs() {
+		keep := applyCallback(args[0], 1, pair.Value, pair.Key)
+		if IsError(keep) {
+			return keep
+		}
+
+		if IsTruthy(keep) {
+			key, _ := HashKeyOf(pair.Key)
+			result.Set(key, pair)
+		}
+	}
+	return result
+}
+
+func hashReduce(hash *Hash, args ...Object) Object {
+	err := Check(
+		"hash.reduce", args,
+		RangeOfArgs(1, 2),
+		callableArgument(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	// as array.reduce, without initial value first value is the accumulator
+	pairs := hash.OrderedPairs()
+	var accumulator Object
+	if len(args) == 2 {
+		accumulator = args[1]
+	} else {
+		if len(pairs) == 0 {
+			return NewErrorFormat("TypeError: hash.reduce() of empty hash with no initial value")
+		}
+		accumulator = pairs[0].Value
+		pairs = pairs[1:]
+	}
+
+	for _, pair := range pairs {
+		accumulator = applyCallback(args[0], 2, accumulator, pair.Value, pair.Key)
+		if IsError(accumulator) {
+			return accumulator
+		}
+	}
+	return accumulator
+}
@@ -0,0 +1,105 @@
+package object
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HashKeyOf get key of an object. Besides Hashable objects, arrays, sets and
+// enum values are hashable when all of their elements are, e.g.: [1, "a"].
+// Values which contain themselves aren't hashable.
+func HashKeyOf(o Object) (HashKey, bool) {
+	return hashKeyOf(o, map[Object]bool{})
+}
+
+// hashKeyOf get key of o, parents are composite values being hashed which
+// contain o, finding one of them again means value is circular
+func hashKeyOf(o Object, parents map[Object]bool) (HashKey, bool) {
+	switch o := o.(type) {
+	case Hashable:
+		return o.HashKey(), true
+	case *Array:
+		return compositeHashKey(o, "", o.Elements, false, parents)
+	case *Set:
+		// sets with same elements are equal regardless of their order
+		return compositeHashKey(o, "", o.Values(), true, parents)
+	case *EnumValue:
+		// cases are only equal when they belong to same enum
+		prefix := fmt.Sprintf("%p::%s", o.Enum, o.Name)
+		return compositeHashKey(o, prefix, o.Payload, false, parents)
+	}
+	return HashKey{}, false
+}
+
+// compositeHashKey build key from keys of elements, each key is written with
+// it is length so different elements never produce same Data.
+func compositeHashKey(o Object, prefix string, elements []Object, unordered bool, parents map[Object]bool) (HashKey, bool) {
+	if parents[o] {
+		return HashKey{}, false
+	}
+	parents[o] = true
+	defer delete(parents, o)
+
+	encoded := make([]string, len(elements))
+	for i, e := range elements {
+		key, ok := hashKeyOf(e, parents)
+		if !ok {
+			return HashKey{}, false
+		}
+		encoded[i] = encodeHashKey(key)
+	}
+
+	if unordered {
+		sort.Strings(encoded)
+	}
+
+	data := prefix + "[" + strings.Join(encoded, "") + "]"
+	h := fnv.New64a()
+	h.Write([]byte(data))
+	return HashKey{Type: o.Type(), Value: h.Sum64(), Data: data}, true
+}
+
+func encodeHashKey(key HashKey) string {
+	out := strings.Builder{}
+	out.WriteString(string(key.Type))
+	out.WriteString(":")
+	out.WriteString(strconv.FormatUint(key.Value, 16))
+	out.WriteString(":")
+	out.WriteString(strconv.Itoa(len(key.Data)))
+	out.WriteString(":")
+	out.WriteString(key.Data)
+	return out.String()
+}
+
+// frozenKey copy mutable composite keys and freeze them, so changing original
+// value after it is used as key doesn't change the key.
+func frozenKey(o Object) Object {
+	switch o := o.(type) {
+	case *Array:
+		if o.IsFrozen() {
+			return o
+		}
+		return Freeze(o.Clone())
+	case *Set:
+		if o.IsFrozen() {
+			return o
+		}
+		return Freeze(o.Clone())
+	case *EnumValue:
+		if o.Payload == nil {
+			return o
+		}
+
+		payload := make([]Object, len(o.Payload))
+		for i, p := range o.Payload {
+			payload[i] = frozenKey(p)
+		}
+		value := *o
+		value.Payload = payload
+		return &value
+	}
+	return o
+}
@@ -0,0 +1,145 @@
+package object
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHashKeyOf(t *testing.T) {
+	enum := &Enum{Name: "Shape", Branches: map[string]Object{}}
+	circle := enum.AddCase("Circle", nil, []string{"r"})
+	square := enum.AddCase("Square", nil, nil)
+	other := &Enum{Name: "Shape", Branches: map[string]Object{}}
+	otherSquare := other.AddCase("Square", nil, nil)
+
+	integers := func(values ...int64) *Array {
+		elements := make([]Object, len(values))
+		for i, v := range values {
+			elements[i] = &Integer{Value: v}
+		}
+		return &Array{Elements: elements}
+	}
+
+	set := func(elements ...Object) *Set {
+		s, _ := NewSet(elements...)
+		return s
+	}
+
+	tests := []struct {
+		left    Object
+		right   Object
+		isEqual bool
+	}{
+		{&String{Value: "a"}, &String{Value: "a"}, true},
+		{&String{Value: "a"}, &String{Value: "b"}, false},
+		{&Float{Value: 0.1234567}, &Float{Value: 0.1234568}, false},
+		{&Float{Value: 0}, &Float{Value: math.Copysign(0, -1)}, true},
+		{integers(1, 2), integers(1, 2), true},
+		{integers(1, 2), integers(2, 1), false},
+		{integers(1, 2), &Array{Elements: []Object{&Integer{Value: 1}, &String{Value: "2"}}}, false},
+		{&Array{Elements: []Object{integers(1), integers()}}, &Array{Elements: []Object{integers(), integers(1)}}, false},
+		{&Array{Elements: []Object{&String{Value: "a:b"}}}, &Array{Elements: []Object{&String{Value: "a"}, &String{Value: "b"}}}, false},
+		{set(&Integer{Value: 1}, &Integer{Value: 2}), set(&Integer{Value: 2}, &Integer{Value: 1}), true},
+		{set(&Integer{Value: 1}), integers(1), false},
+		{square, square, true},
+		{square, otherSquare, false},
+		{circle.Construct(&Integer{Value: 1}), circle.Construct(&Integer{Value: 1}), true},
+		{circle.Construct(&Integer{Value: 1}), circle.Construct(&Integer{Value: 2}), false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashKeyOf[%d]", i), func(t *testing.T) {
+			left, ok := HashKeyOf(tt.left)
+			if !ok {
+				t.Fatalf("%s expected to be hashable", tt.left.Inspect())
+			}
+
+			right, ok := HashKeyOf(tt.right)
+			if !ok {
+				t.Fatalf("%s expected to be hashable", tt.right.Inspect())
+			}
+
+			if (left == right) != tt.isEqual {
+				t.Errorf("keys of %s and %s expected equal to be %v", tt.left.Inspect(), tt.right.Inspect(), tt.isEqual)
+			}
+		})
+	}
+}
+
+func TestHashKeyOfUnhashable(t *testing.T) {
+	tests := []Object{
+		&Hash{},
+		&Array{Elements: []Object{&Hash{}}},
+		&Array{Elements: []Object{&Array{Elements: []Object{&Builtin{}}}}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashKeyOfUnhashable[%d]", i), func(t *testing.T) {
+			if _, ok := HashKeyOf(tt); ok {
+				t.Errorf("%T expected to not be hashable", tt)
+			}
+		})
+	}
+}
+
+func TestHashKeyOfCircular(t *testing.T) {
+	self := &Array{Elements: []Object{&Integer{Value: 1}}}
+	self.Elements = append(self.Elements, self)
+	self.Freeze()
+
+	inner := &Array{}
+	outer := &Array{Elements: []Object{inner}}
+	inner.Elements = []Object{outer}
+
+	shared := &Array{Elements: []Object{&Integer{Value: 1}}}
+
+	tests := []struct {
+		value    Object
+		hashable bool
+	}{
+		{self, false},
+		{outer, false},
+		{&Array{Elements: []Object{self}}, false},
+		{&Array{Elements: []Object{shared, shared}}, true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHashKeyOfCircular[%d]", i), func(t *testing.T) {
+			if _, ok := HashKeyOf(tt.value); ok != tt.hashable {
+				t.Errorf("expected hashable to be %v. Got: %v", tt.hashable, ok)
+			}
+		})
+	}
+}
+
+func TestHashCollidingKeysKeepBothPairs(t *testing.T) {
+	h := NewHash()
+	a := HashKey{Type: STRING_OBJ, Value: 1, Data: "a"}
+	b := HashKey{Type: STRING_OBJ, Value: 1, Data: "b"}
+
+	h.Set(a, HashPair{Key: &String{Value: "a"}, Value: &Integer{Value: 1}})
+	h.Set(b, HashPair{Key: &String{Value: "b"}, Value: &Integer{Value: 2}})
+
+	if h.Inspect() != "{a: 1, b: 2}" {
+		t.Fatalf("Hash.Inspect() expected {a: 1, b: 2}. Got: %s", h.Inspect())
+	}
+}
+
+func TestHashCompositeKeyIsFrozenCopy(t *testing.T) {
+	key := &Array{Elements: []Object{&Integer{Value: 1}}}
+	hashKey, _ := HashKeyOf(key)
+
+	h := NewHash()
+	h.Set(hashKey, HashPair{Key: key, Value: TRUE})
+	key.Elements[0] = &Integer{Value: 2}
+
+	pair, ok := h.Get(hashKey)
+	if !ok {
+		t.Fatalf("Hash.Get() expected to find key [1]")
+	}
+
+	if pair.Key.Inspect() != "[1]" || !IsFrozen(pair.Key) {
+		t.Errorf("key expected to be a frozen copy of [1]. Got: %s", pair.Key.Inspect())
+	}
+}
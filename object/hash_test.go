@@ -0,0 +1,65 @@
+package object
+
+import "testing"
+
+func setPair(h *Hash, key Object, value Object) {
+	h.Set(key.(Hashable).HashKey(), HashPair{Key: key, Value: value})
+}
+
+func TestHash_InspectKeepInsertionOrder(t *testing.T) {
+	h := NewHash()
+	setPair(h, &String{Value: "z"}, &Integer{Value: 1})
+	setPair(h, &Integer{Value: 10}, &Integer{Value: 2})
+	setPair(h, TRUE, &Integer{Value: 3})
+	setPair(h, &String{Value: "a"}, &Integer{Value: 4})
+	setPair(h, &String{Value: "z"}, &Integer{Value: 5})
+
+	if h.Inspect() != "{z: 5, 10: 2, true: 3, a: 4}" {
+		t.Fatalf("Hash.Inspect() expected {z: 5, 10: 2, true: 3, a: 4}. Got: %s", h.Inspect())
+	}
+}
+
+func TestHash_DeleteKeepInsertionOrder(t *testing.T) {
+	h := NewHash()
+	for i := 0; i < 10; i++ {
+		setPair(h, &Integer{Value: int64(i)}, &Integer{Value: int64(i)})
+	}
+
+	for i := 0; i < 8; i++ {
+		h.Delete((&Integer{Value: int64(i)}).HashKey())
+	}
+	setPair(h, &Integer{Value: 0}, &Integer{Value: 0})
+	h.Delete((&Integer{Value: 100}).HashKey())
+
+	if h.Inspect() != "{8: 8, 9: 9, 0: 0}" {
+		t.Fatalf("Hash.Inspect() expected {8: 8, 9: 9, 0: 0}. Got: %s", h.Inspect())
+	}
+
+	if len(h.keys) > 2*len(h.Pairs) {
+		t.Fatalf("Hash keys weren't compacted. Got: %d keys for %d pairs", len(h.keys), len(h.Pairs))
+	}
+
+	if _, ok := h.Get((&Integer{Value: 9}).HashKey()); !ok {
+		t.Fatalf("Hash.Get() expected to find key 9")
+	}
+}
+
+func TestHash_ZeroValueSet(t *testing.T) {
+	h := &Hash{}
+	setPair(h, &String{Value: "a"}, &Integer{Value: 1})
+
+	if h.Inspect() != "{a: 1}" {
+		t.Fatalf("Hash.Inspect() expected {a: 1}. Got: %s", h.Inspect())
+	}
+}
+
+func BenchmarkHashInspect(b *testing.B) {
+	h := NewHash()
+	for i := 0; i < 100; i++ {
+		setPair(h, &Integer{Value: int64(i)}, &String{Value: "value"})
+	}
+
+	for n := 0; n < b.N; n++ {
+		h.Inspect()
+	}
+}
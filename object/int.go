@@ -2,6 +2,8 @@ package object
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -17,6 +19,10 @@ func (i *Integer) HashKey() HashKey {
 }
 
 func (i *Integer) Compare(right Object) int8 {
+	if obj, ok := right.(*BigInt); ok {
+		return int8(big.NewInt(i.Value).Cmp(obj.Value))
+	}
+
 	if obj, ok := right.(*Integer); ok {
 		switch {
 		case i.Value < obj.Value:
@@ -75,6 +81,10 @@ func (s *Integer) Call(method string, args ...Object) Object {
 		if err != nil {
 			return NewError(err.Error())
 		}
+		if s.Value == math.MinInt64 {
+			return NewInteger(new(big.Int).Neg(big.NewInt(s.Value)))
+		}
+
 		var absT int64 = s.Value
 		if s.Value < 0 {
 			absT = -s.Value
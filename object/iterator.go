@@ -0,0 +1,77 @@
+package object
+
+// Iterator produce values lazily, next function give nil when there are no
+// more values.
+type Iterator struct {
+	next func() Object
+	done bool
+}
+
+func NewIterator(next func() Object) *Iterator {
+	return &Iterator{next: next}
+}
+
+func (it *Iterator) Type() ObjectType { return ITERATOR_OBJ }
+func (it *Iterator) Inspect() string  { return "iterator" }
+
+// Next get next value, once it is exhausted it always give nil
+func (it *Iterator) Next() Object {
+	if it.done {
+		return nil
+	}
+
+	value := it.next()
+	if value == nil {
+		it.done = true
+	}
+	return value
+}
+
+func (it *Iterator) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"iterator.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: ITERATOR_OBJ}
+	case "next":
+		err := Check(
+			"iterator.next", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		value := it.Next()
+		if value == nil {
+			return NULL
+		}
+		return value
+	case "toArray":
+		err := Check(
+			"iterator.toArray", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		elements := []Object{}
+		for value := it.Next(); value != nil; value = it.Next() {
+			if IsError(value) {
+				return value
+			}
+			elements = append(elements, value)
+		}
+		return &Array{Elements: elements}
+	}
+	return NewErrorFormat("method %s not exists on iterator object.", method)
+}
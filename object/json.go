@@ -0,0 +1,317 @@
+package object
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EncodeJSON render object as JSON, hash keys are written as strings and sets
+// as arrays. Functions, modules and other values without JSON representation
+// can't be encoded. Name is used on error messages.
+func EncodeJSON(name string, o Object) (string, error) {
+	out := bytes.Buffer{}
+	if err := encodeJSON(name, &out, o, map[Object]bool{}); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func encodeJSON(name string, out *bytes.Buffer, o Object, seen map[Object]bool) error {
+	switch o := o.(type) {
+	case nil, *Null:
+		out.WriteString("null")
+	case *Boolean:
+		out.WriteString(strconv.FormatBool(o.Value))
+	case *Integer:
+		out.WriteString(strconv.FormatInt(o.Value, 10))
+	case *BigInt:
+		out.WriteString(o.Value.String())
+	case *Decimal:
+		out.WriteString(o.String())
+	case *Float:
+		if math.IsNaN(o.Value) || math.IsInf(o.Value, 0) {
+			return fmt.Errorf("ValueError: %s() unable to encode %s to json", name, o.Inspect())
+		}
+
+		value := strconv.FormatFloat(o.Value, 'f', -1, 64)
+		if o.Value == math.Trunc(o.Value) {
+			value += ".0"
+		}
+		out.WriteString(value)
+	case *String:
+		encodeJSONString(out, o.Value)
+	case *EnumValue:
+		encodeJSONString(out, o.Inspect())
+	case *Array:
+		return encodeJSONArray(name, out, o, o.Elements, seen)
+	case *Set:
+		return encodeJSONArray(name, out, o, o.Values(), seen)
+	case *Hash:
+		if seen[o] {
+			return fmt.Errorf("ValueError: %s() unable to encode circular reference to json", name)
+		}
+		seen[o] = true
+		defer delete(seen, o)
+
+		out.WriteString("{")
+		for i, pair := range o.OrderedPairs() {
+			if i > 0 {
+				out.WriteString(",")
+			}
+
+			switch key := pair.Key.(type) {
+			case *String:
+				encodeJSONString(out, key.Value)
+			case *Integer, *BigInt, *Decimal, *Float, *Boolean, *EnumValue:
+				encodeJSONString(out, key.Inspect())
+			default:
+				return fmt.Errorf("TypeError: %s() unable to encode `%s` as json key", name, key.Type())
+			}
+
+			out.WriteString(":")
+			if err := encodeJSON(name, out, pair.Value, seen); err != nil {
+				return err
+			}
+		}
+		out.WriteString("}")
+	default:
+		return fmt.Errorf("TypeError: %s() unable to encode `%s` to json", name, o.Type())
+	}
+	return nil
+}
+
+func encodeJSONArray(name string, out *bytes.Buffer, o Object, elements []Object, seen map[Object]bool) error {
+	if seen[o] {
+		return fmt.Errorf("ValueError: %s() unable to encode circular reference to json", name)
+	}
+	seen[o] = true
+	defer delete(seen, o)
+
+	out.WriteString("[")
+	for i, e := range elements {
+		if i > 0 {
+			out.WriteString(",")
+		}
+
+		if err := encodeJSON(name, out, e, seen); err != nil {
+			return err
+		}
+	}
+	out.WriteString("]")
+	return nil
+}
+
+func encodeJSONString(out *bytes.Buffer, value string) {
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	// encoder always end value with a new line
+	out.Truncate(out.Len() - 1)
+}
+
+// DecodeJSON parse a single JSON value, objects become hashes keeping order of
+// their keys, integers become Integer (or BigInt when they don't fit) and
+// other numbers Float. Name is used on error messages.
+func DecodeJSON(name string, input string) (Object, error) {
+	decoder := NewJSONDecoder(name, strings.NewReader(input), false)
+	value, err := decoder.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return nil, decoder.error(io.EOF)
+	}
+
+	offset := decoder.decoder.InputOffset()
+	rest := strings.TrimLeftFunc(input[offset:], unicode.IsSpace)
+	if rest != "" {
+		line, column := decoder.reader.position(int64(len(input) - len(rest)))
+		return nil, fmt.Errorf("SyntaxError: %s() unexpected data after value at line %d, column %d", name, line, column)
+	}
+	return value, nil
+}
+
+// JSONDecoder decode JSON values one at a time from a reader, so large inputs
+// don't need to be kept on memory. When elements is true, input must be an
+// array and each element is decoded at a time, otherwise it decode values
+// which follow each other, e.g.: one per line.
+type JSONDecoder struct {
+	name     string
+	reader   *positionReader
+	decoder  *json.Decoder
+	elements bool
+	started  bool
+}
+
+func NewJSONDecoder(name string, reader io.Reader, elements bool) *JSONDecoder {
+	position := &positionReader{reader: reader}
+	decoder := json.NewDecoder(position)
+	decoder.UseNumber()
+	return &JSONDecoder{name: name, reader: position, decoder: decoder, elements: elements}
+}
+
+// Next decode next value, it give nil at end of input
+func (d *JSONDecoder) Next() (Object, error) {
+	if !d.elements {
+		token, err := d.decoder.Token()
+		if err == io.EOF {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, d.error(err)
+		}
+		return d.decodeToken(token)
+	}
+
+	if !d.started {
+		d.started = true
+		token, err := d.decoder.Token()
+		if err != nil {
+			return nil, d.error(err)
+		}
+
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			line, column := d.reader.position(d.decoder.InputOffset() - 1)
+			return nil, fmt.Errorf("SyntaxError: %s() expected an array at line %d, column %d", d.name, line, column)
+		}
+	}
+
+	if !d.decoder.More() {
+		if _, err := d.decoder.Token(); err != nil && err != io.EOF {
+			return nil, d.error(err)
+		}
+		return nil, nil
+	}
+	return d.decodeValue()
+}
+
+func (d *JSONDecoder) decodeValue() (Object, error) {
+	token, err := d.decoder.Token()
+	if err != nil {
+		return nil, d.error(err)
+	}
+	return d.decodeToken(token)
+}
+
+func (d *JSONDecoder) decodeToken(token json.Token) (Object, error) {
+	switch token := token.(type) {
+	case nil:
+		return NULL, nil
+	case bool:
+		return nativeBoolToBooleanObject(token), nil
+	case string:
+		return &String{Value: token}, nil
+	case json.Number:
+		return d.decodeNumber(token)
+	case json.Delim:
+		if token == '[' {
+			elements := []Object{}
+			for d.decoder.More() {
+				value, err := d.decodeValue()
+				if err != nil {
+					return nil, err
+				}
+				elements = append(elements, value)
+			}
+			return &Array{Elements: elements}, d.closeDelim()
+		}
+
+		hash := NewHash()
+		for d.decoder.More() {
+			key, err := d.decoder.Token()
+			if err != nil {
+				return nil, d.error(err)
+			}
+
+			value, err := d.decodeValue()
+			if err != nil {
+				return nil, err
+			}
+
+			keyObject := &String{Value: key.(string)}
+			hash.Set(keyObject.HashKey(), HashPair{Key: keyObject, Value: value})
+		}
+		return hash, d.closeDelim()
+	}
+	return nil, fmt.Errorf("SyntaxError: %s() unexpected token %v", d.name, token)
+}
+
+func (d *JSONDecoder) closeDelim() error {
+	if _, err := d.decoder.Token(); err != nil {
+		return d.error(err)
+	}
+	return nil
+}
+
+func (d *JSONDecoder) decodeNumber(number json.Number) (Object, error) {
+	if !strings.ContainsAny(number.String(), ".eE") {
+		value, ok := new(big.Int).SetString(number.String(), 10)
+		if ok {
+			return NewInteger(value), nil
+		}
+	}
+
+	value, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil {
+		line, column := d.reader.position(d.decoder.InputOffset() - int64(len(number)))
+		return nil, fmt.Errorf("SyntaxError: %s() number %s out of range at line %d, column %d", d.name, number, line, column)
+	}
+	return &Float{Value: value}, nil
+}
+
+// error convert errors of decoder into ninja errors with line and column
+func (d *JSONDecoder) error(err error) error {
+	if syntaxErr, ok := err.(*json.SyntaxError); ok && syntaxErr.Error() != "unexpected end of JSON input" {
+		line, column := d.reader.position(syntaxErr.Offset - 1)
+		return fmt.Errorf("SyntaxError: %s() %s at line %d, column %d", d.name, syntaxErr, line, column)
+	}
+
+	if _, ok := err.(*json.SyntaxError); ok || err == io.EOF || err == io.ErrUnexpectedEOF {
+		line, column := d.reader.position(d.reader.read)
+		return fmt.Errorf("SyntaxError: %s() unexpected end of input at line %d, column %d", d.name, line, column)
+	}
+	return fmt.Errorf("IOError: %s() %s", d.name, err)
+}
+
+// positionReader count bytes read and remember where lines start, so errors
+// can tell line and column even when input is streamed
+type positionReader struct {
+	reader io.Reader
+	read   int64
+	lines  []int64 // offset where each line, after first one, start
+}
+
+func (r *positionReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == '\n' {
+			r.lines = append(r.lines, r.read+int64(i)+1)
+		}
+	}
+	r.read += int64(n)
+	return n, err
+}
+
+// position get line and column (both starting at 1) of byte at offset
+func (r *positionReader) position(offset int64) (int64, int64) {
+	if offset < 0 {
+		offset = 0
+	}
+
+	line := sort.Search(len(r.lines), func(i int) bool { return r.lines[i] > offset })
+	start := int64(0)
+	if line > 0 {
+		start = r.lines[line-1]
+	}
+	return int64(line) + 1, offset - start + 1
+}
@@ -0,0 +1,143 @@
+package object
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	hash := NewHash()
+	key := &String{Value: "b"}
+	hash.Set(key.HashKey(), HashPair{Key: key, Value: &Array{Elements: []Object{TRUE, NULL}}})
+	key = &String{Value: "a"}
+	hash.Set(key.HashKey(), HashPair{Key: key, Value: &Float{Value: 1}})
+
+	tests := []struct {
+		input    Object
+		expected string
+	}{
+		{NULL, "null"},
+		{&Integer{Value: -1}, "-1"},
+		{&Float{Value: 1.5}, "1.5"},
+		{&Float{Value: 2}, "2.0"},
+		{&String{Value: "a\"<b>\n"}, `"a\"<b>\n"`},
+		{&Array{Elements: []Object{}}, "[]"},
+		{hash, `{"b":[true,null],"a":1.0}`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestEncodeJSON[%d]", i), func(t *testing.T) {
+			result, err := EncodeJSON("encode", tt.input)
+			if err != nil {
+				t.Fatalf("EncodeJSON() unexpected error %s", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("EncodeJSON() expected %s. Got: %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEncodeJSONErrors(t *testing.T) {
+	tests := []struct {
+		input    Object
+		expected string
+	}{
+		{&Float{Value: math.NaN()}, "ValueError: encode() unable to encode NaN to json"},
+		{&Builtin{}, "TypeError: encode() unable to encode `BUILTIN` to json"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestEncodeJSONErrors[%d]", i), func(t *testing.T) {
+			_, err := EncodeJSON("encode", tt.input)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("EncodeJSON() expected error %s. Got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		typ      ObjectType
+	}{
+		{`null`, "null", NULL_OBJ},
+		{` true `, "true", BOOLEAN_OBJ},
+		{`1`, "1", INTEGER_OBJ},
+		{`-12345678901234567890`, "-12345678901234567890", BIGINT_OBJ},
+		{`1.5`, "1.500000", FLOAT_OBJ},
+		{`1e2`, "100.000000", FLOAT_OBJ},
+		{`"aé\n"`, "aé\n", STRING_OBJ},
+		{`[1, [2], []]`, "[1, [2], []]", ARRAY_OBJ},
+		{`{"b": 1, "a": {"c": null}}`, "{b: 1, a: {c: null}}", HASH_OBJ},
+		{`{"a": 1, "a": 2}`, "{a: 2}", HASH_OBJ},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestDecodeJSON[%d]", i), func(t *testing.T) {
+			result, err := DecodeJSON("decode", tt.input)
+			if err != nil {
+				t.Fatalf("DecodeJSON() unexpected error %s", err)
+			}
+
+			if result.Type() != tt.typ {
+				t.Errorf("DecodeJSON() expected type %s. Got: %s", tt.typ, result.Type())
+			}
+
+			if result.Inspect() != tt.expected {
+				t.Errorf("DecodeJSON() expected %s. Got: %s", tt.expected, result.Inspect())
+			}
+		})
+	}
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{``, "SyntaxError: decode() unexpected end of input at line 1, column 1"},
+		{`[1, 2`, "SyntaxError: decode() unexpected end of input at line 1, column 6"},
+		{"{\"a\": 1,\n \"b\" 2}", "SyntaxError: decode() invalid character '2' after object key at line 2, column 6"},
+		{"[1,\n\n,2]", "SyntaxError: decode() invalid character ',' looking for beginning of value at line 3, column 1"},
+		{`{1: 2}`, "SyntaxError: decode() object member name must be a string at line 1, column 2"},
+		{`[1] 2`, "SyntaxError: decode() unexpected data after value at line 1, column 5"},
+		{`tru`, "SyntaxError: decode() unexpected end of input at line 1, column 4"},
+		{`1e999`, "SyntaxError: decode() number 1e999 out of range at line 1, column 1"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestDecodeJSONErrors[%d]", i), func(t *testing.T) {
+			_, err := DecodeJSON("decode", tt.input)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("DecodeJSON() expected error %s. Got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestJSONDecoder(t *testing.T) {
+	decoder := NewJSONDecoder("decoder", strings.NewReader("[{\"a\": 1},\n 2, [3]]"), true)
+
+	expected := []string{"{a: 1}", "2", "[3]"}
+	for i, e := range expected {
+		value, err := decoder.Next()
+		if err != nil {
+			t.Fatalf("JSONDecoder.Next() unexpected error %s", err)
+		}
+
+		if value.Inspect() != e {
+			t.Errorf("JSONDecoder.Next() value #%d expected %s. Got: %s", i, e, value.Inspect())
+		}
+	}
+
+	value, err := decoder.Next()
+	if value != nil || err != nil {
+		t.Errorf("JSONDecoder.Next() expected end of input. Got: %v, %v", value, err)
+	}
+}
@@ -0,0 +1,74 @@
+package object
+
+import (
+	"strings"
+)
+
+// Module hold values exported by an imported file, each module is evaluated on
+// it is own environment.
+type Module struct {
+	Name    string // filename of module
+	Exports map[string]Object
+	names   []string // exported names by declaration order
+}
+
+func NewModule(name string) *Module {
+	return &Module{Name: name, Exports: map[string]Object{}}
+}
+
+func (m *Module) Type() ObjectType { return MODULE_OBJ }
+func (m *Module) Inspect() string {
+	out := strings.Builder{}
+	out.WriteString("module \"")
+	out.WriteString(m.Name)
+	out.WriteString("\" {")
+	out.WriteString(strings.Join(m.names, ", "))
+	out.WriteString("}")
+	return out.String()
+}
+
+// Export make value visible by name to importers
+func (m *Module) Export(name string, value Object) {
+	if _, ok := m.Exports[name]; !ok {
+		m.names = append(m.names, name)
+	}
+	m.Exports[name] = value
+}
+
+// Lookup get exported value by name
+func (m *Module) Lookup(name string) (Object, bool) {
+	value, ok := m.Exports[name]
+	return value, ok
+}
+
+func (m *Module) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"module.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		return &String{Value: MODULE_OBJ}
+	case "exports":
+		err := Check(
+			"module.exports", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		elements := make([]Object, len(m.names))
+		for i, name := range m.names {
+			elements[i] = &String{Value: name}
+		}
+		return &Array{Elements: elements}
+	}
+	return NewErrorFormat("method %s not exists on module %s.", method, m.Name)
+}
@@ -0,0 +1,31 @@
+package object
+
+import "testing"
+
+func TestModule_Export(t *testing.T) {
+	module := NewModule("lib.nj")
+	module.Export("b", &Integer{Value: 2})
+	module.Export("a", &Integer{Value: 1})
+	module.Export("b", &Integer{Value: 3})
+
+	if module.Inspect() != `module "lib.nj" {b, a}` {
+		t.Errorf("module.Inspect() is wrong. Got: %s", module.Inspect())
+	}
+
+	value, ok := module.Lookup("b")
+	if !ok {
+		t.Fatalf("module.Lookup(b) not found")
+	}
+	if integer, ok := value.(*Integer); !ok || integer.Value != 3 {
+		t.Errorf("module.Lookup(b) expected 3. Got: %s", value.Inspect())
+	}
+
+	if _, ok := module.Lookup("c"); ok {
+		t.Errorf("module.Lookup(c) shouldn't be found")
+	}
+
+	exports := module.Call("exports")
+	if exports.Inspect() != "[b, a]" {
+		t.Errorf("module.exports() is wrong. Got: %s", exports.Inspect())
+	}
+}
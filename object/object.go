@@ -21,6 +21,13 @@ type Cloneable interface {
 	Clone() Object
 }
 
+// Freezable is implemented by objects which can be made read-only, once frozen
+// any attempt of mutation must fail.
+type Freezable interface {
+	Freeze()
+	IsFrozen() bool
+}
+
 // Comparable is the interface for comparing two Object and their underlying
 // values. It is the responsibility of the caller (left) to check for types.
 // E.g.: 1 > 1, it will return -1. left isn't greater than 1.
@@ -28,13 +35,17 @@ type Comparable interface {
 	Compare(right Object) int8
 }
 
-// HashKey hold "key" on Hash
+// HashKey hold "key" on Hash. Value is a hash of object and Data keep it is exact
+// representation when Value alone can't tell objects apart (e.g.: strings), so
+// keys whose hashes collide are still compared by real equality.
 type HashKey struct {
 	Type  ObjectType
 	Value uint64
+	Data  string
 }
 
-// Hashable exist for object implement it in order to be used in Hash object
+// Hashable exist for object implement it in order to be used in Hash object,
+// composite objects are hashable through HashKeyOf.
 type Hashable interface {
 	HashKey() HashKey
 }
@@ -45,15 +56,24 @@ const (
 	RETURN_VALUE_OBJ = "RETURN_VALUE"
 	BREAK_VALUE_OBJ  = "BREAK_VALUE"
 	ENUM_OBJ         = "ENUM"
+	ENUM_VALUE_OBJ   = "ENUM_VALUE"
 	FUNCTION_OBJ     = "FUNCTION"
 	BUILTIN_OBJ      = "BUILTIN"
 	INTEGER_OBJ      = "INTEGER"
+	BIGINT_OBJ       = "BIGINT"
+	DECIMAL_OBJ      = "DECIMAL"
 	FLOAT_OBJ        = "FLOAT"
 	BOOLEAN_OBJ      = "BOOLEAN"
 	STRING_OBJ       = "STRING"
 	ARRAY_OBJ        = "ARRAY"
 	HASH_OBJ         = "HASH"
 	PLUGIN_OBJ       = "PLUGIN"
+	QUOTE_OBJ        = "QUOTE"
+	MACRO_OBJ        = "MACRO"
+	MODULE_OBJ       = "MODULE"
+	SET_OBJ          = "SET"
+	ITERATOR_OBJ     = "ITERATOR"
+	REGEX_OBJ        = "REGEX"
 )
 
 func IsError(o Object) bool {
@@ -80,7 +100,7 @@ func IsTruthy(o Object) bool {
 }
 
 func IsNumber(o Object) bool {
-	return o != nil && (o.Type() == INTEGER_OBJ || o.Type() == FLOAT_OBJ)
+	return o != nil && (o.Type() == INTEGER_OBJ || o.Type() == BIGINT_OBJ || o.Type() == FLOAT_OBJ || o.Type() == DECIMAL_OBJ)
 }
 
 func IsArray(o Object) bool {
@@ -91,10 +111,28 @@ func IsHash(o Object) bool {
 	return o != nil && o.Type() == HASH_OBJ
 }
 
+func IsSet(o Object) bool {
+	return o != nil && o.Type() == SET_OBJ
+}
+
 func IsString(o Object) bool {
 	return o != nil && o.Type() == STRING_OBJ
 }
 
+// Freeze make o deeply read-only when it is Freezable, otherwise it keeps
+// o untouched as it is already immutable.
+func Freeze(o Object) Object {
+	if freezable, ok := o.(Freezable); ok {
+		freezable.Freeze()
+	}
+	return o
+}
+
+func IsFrozen(o Object) bool {
+	freezable, ok := o.(Freezable)
+	return ok && freezable.IsFrozen()
+}
+
 func InspectObject(args ...Object) string {
 	var out bytes.Buffer
 	elements := make([]string, len(args))
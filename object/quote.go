@@ -0,0 +1,13 @@
+package object
+
+import "github.com/gravataLonga/ninja/ast"
+
+// Quote hold an unevaluated node, it is what quote() return
+type Quote struct {
+	Node ast.Node
+}
+
+func (q *Quote) Type() ObjectType { return QUOTE_OBJ }
+func (q *Quote) Inspect() string {
+	return "QUOTE(" + q.Node.String() + ")"
+}
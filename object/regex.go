@@ -0,0 +1,275 @@
+package object
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Regex is a compiled regular expression, it use syntax of Go's regexp package
+type Regex struct {
+	Source string
+	Flags  string
+	regexp *regexp.Regexp
+}
+
+// NewRegex compile pattern, flags are letters which change how pattern match:
+// i (case insensitive), m (multi line), s (dot match new line) and U (ungreedy)
+func NewRegex(pattern string, flags string) (*Regex, error) {
+	for _, flag := range flags {
+		if !strings.ContainsRune("imsU", flag) {
+			return nil, fmt.Errorf("ValueError: regex() unknown flag %c, expected one of i, m, s, U", flag)
+		}
+	}
+
+	source := pattern
+	if flags != "" {
+		source = "(?" + flags + ")" + pattern
+	}
+
+	compiled, err := regexp.Compile(source)
+	if err != nil {
+		return nil, fmt.Errorf("SyntaxError: regex() %s", err)
+	}
+	return &Regex{Source: pattern, Flags: flags, regexp: compiled}, nil
+}
+
+func (r *Regex) Type() ObjectType { return REGEX_OBJ }
+func (r *Regex) Inspect() string {
+	if r.Flags == "" {
+		return fmt.Sprintf("regex(%q)", r.Source)
+	}
+	return fmt.Sprintf("regex(%q, %q)", r.Source, r.Flags)
+}
+
+func (r *Regex) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"regex.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: REGEX_OBJ}
+	case "source":
+		err := Check(
+			"regex.source", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: r.Source}
+	case "test":
+		return regexTest(r, args...)
+	case "find":
+		return regexFind(r, args...)
+	case "findAll":
+		return regexFindAll(r, args...)
+	case "match":
+		return regexMatch(r, args...)
+	case "matchAll":
+		return regexMatchAll(r, args...)
+	case "replace":
+		return regexReplace(r, args...)
+	case "split":
+		return regexSplit(r, args...)
+	}
+	return NewErrorFormat("method %s not exists on regex object.", method)
+}
+
+// matchHash describe a match found at indexes (as given by FindStringSubmatchIndex)
+// of str: {"match": "...", "index": 0, "groups": [...], "named": {...}}, index
+// count characters like string.index(), groups which didn't participate on
+// match are null.
+func (r *Regex) matchHash(str string, indexes []int) *Hash {
+	groups := []Object{}
+	named := NewHash()
+	for i, name := range r.regexp.SubexpNames() {
+		if i == 0 {
+			continue
+		}
+
+		var value Object = NULL
+		if indexes[2*i] >= 0 {
+			value = &String{Value: str[indexes[2*i]:indexes[2*i+1]]}
+		}
+		groups = append(groups, value)
+
+		if name != "" {
+			key := &String{Value: name}
+			named.Set(key.HashKey(), HashPair{Key: key, Value: value})
+		}
+	}
+
+	hash := NewHash()
+	for _, pair := range []HashPair{
+		{Key: &String{Value: "match"}, Value: &String{Value: str[indexes[0]:indexes[1]]}},
+		{Key: &String{Value: "index"}, Value: &Integer{Value: int64(utf8.RuneCountInString(str[:indexes[0]]))}},
+		{Key: &String{Value: "groups"}, Value: &Array{Elements: groups}},
+		{Key: &String{Value: "named"}, Value: named},
+	} {
+		hash.Set(pair.Key.(*String).HashKey(), pair)
+	}
+	return hash
+}
+
+func regexTest(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.test", args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+	return nativeBoolToBooleanObject(r.regexp.MatchString(args[0].(*String).Value))
+}
+
+func regexFind(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.find", args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	str := args[0].(*String).Value
+	indexes := r.regexp.FindStringIndex(str)
+	if indexes == nil {
+		return NULL
+	}
+	return &String{Value: str[indexes[0]:indexes[1]]}
+}
+
+func regexFindAll(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.findAll", args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := []Object{}
+	for _, found := range r.regexp.FindAllString(args[0].(*String).Value, -1) {
+		elements = append(elements, &String{Value: found})
+	}
+	return &Array{Elements: elements}
+}
+
+func regexMatch(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.match", args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	str := args[0].(*String).Value
+	indexes := r.regexp.FindStringSubmatchIndex(str)
+	if indexes == nil {
+		return NULL
+	}
+	return r.matchHash(str, indexes)
+}
+
+func regexMatchAll(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.matchAll", args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	str := args[0].(*String).Value
+	elements := []Object{}
+	for _, indexes := range r.regexp.FindAllStringSubmatchIndex(str, -1) {
+		elements = append(elements, r.matchHash(str, indexes))
+	}
+	return &Array{Elements: elements}
+}
+
+// regexReplace replace every match, replacement is either a string where $1 or
+// ${name} refer to groups, or a function which receive match (same as
+// regex.match() give) and return a string.
+func regexReplace(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.replace", args,
+		ExactArgs(2),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	str := args[0].(*String).Value
+	switch replacement := args[1].(type) {
+	case *String:
+		return &String{Value: r.regexp.ReplaceAllString(str, replacement.Value)}
+	case *FunctionLiteral, *Builtin:
+	default:
+		return NewErrorFormat("TypeError: regex.replace() expected argument #2 to be `%s` or `%s` got `%s`", STRING_OBJ, FUNCTION_OBJ, replacement.Type())
+	}
+
+	out := strings.Builder{}
+	last := 0
+	for _, indexes := range r.regexp.FindAllStringSubmatchIndex(str, -1) {
+		result := applyCallback(args[1], 1, r.matchHash(str, indexes))
+		if IsError(result) {
+			return result
+		}
+
+		value, ok := result.(*String)
+		if !ok {
+			return NewErrorFormat("TypeError: regex.replace() expected function to return `%s` got `%s`", STRING_OBJ, result.Type())
+		}
+
+		out.WriteString(str[last:indexes[0]])
+		out.WriteString(value.Value)
+		last = indexes[1]
+	}
+	out.WriteString(str[last:])
+	return &String{Value: out.String()}
+}
+
+func regexSplit(r *Regex, args ...Object) Object {
+	err := Check(
+		"regex.split", args,
+		RangeOfArgs(1, 2),
+		WithTypes(STRING_OBJ, INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	limit := -1
+	if len(args) == 2 {
+		limit = int(args[1].(*Integer).Value)
+	}
+
+	elements := []Object{}
+	for _, part := range r.regexp.Split(args[0].(*String).Value, limit) {
+		elements = append(elements, &String{Value: part})
+	}
+	return &Array{Elements: elements}
+}
@@ -0,0 +1,269 @@
+package object
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Set is a collection of unique hashable elements, it keeps elements by order
+// they were added.
+type Set struct {
+	elements map[HashKey]Object
+	keys     []HashKey
+	frozen   bool
+}
+
+// NewSet create a set with elements, duplicated elements are added once
+func NewSet(elements ...Object) (*Set, error) {
+	s := &Set{elements: make(map[HashKey]Object, len(elements))}
+	for _, e := range elements {
+		if err := s.Add(e); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *Set) Type() ObjectType { return SET_OBJ }
+func (s *Set) Inspect() string {
+	if len(s.keys) == 0 {
+		return "set()"
+	}
+
+	var out bytes.Buffer
+	elements := make([]string, len(s.keys))
+	for i, e := range s.Values() {
+		elements[i] = e.Inspect()
+	}
+	out.WriteString("{")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("}")
+	return out.String()
+}
+
+// Add element to set, element must be hashable and composite elements are
+// stored as frozen copies
+func (s *Set) Add(element Object) error {
+	key, ok := HashKeyOf(element)
+	if !ok {
+		return fmt.Errorf("unusable as set element: %s", element.Type())
+	}
+
+	if _, ok := s.elements[key]; ok {
+		return nil
+	}
+
+	s.elements[key] = frozenKey(element)
+	s.keys = append(s.keys, key)
+	return nil
+}
+
+// Remove element from set, it tells if element were on set
+func (s *Set) Remove(element Object) bool {
+	key, ok := HashKeyOf(element)
+	if !ok {
+		return false
+	}
+
+	if _, ok := s.elements[key]; !ok {
+		return false
+	}
+
+	delete(s.elements, key)
+	for i, k := range s.keys {
+		if k == key {
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Has tell if element is on set
+func (s *Set) Has(element Object) bool {
+	key, ok := HashKeyOf(element)
+	if !ok {
+		return false
+	}
+
+	_, ok = s.elements[key]
+	return ok
+}
+
+// Values get elements by order they were added
+func (s *Set) Values() []Object {
+	values := make([]Object, len(s.keys))
+	for i, key := range s.keys {
+		values[i] = s.elements[key]
+	}
+	return values
+}
+
+func (s *Set) Len() int { return len(s.keys) }
+
+// Union is a new set with elements of both sets
+func (s *Set) Union(other *Set) *Set {
+	result, _ := NewSet(s.Values()...)
+	for _, e := range other.Values() {
+		result.Add(e)
+	}
+	return result
+}
+
+// Intersection is a new set with elements which are on both sets
+func (s *Set) Intersection(other *Set) *Set {
+	result, _ := NewSet()
+	for _, e := range s.Values() {
+		if other.Has(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// Difference is a new set with elements which aren't on other set
+func (s *Set) Difference(other *Set) *Set {
+	result, _ := NewSet()
+	for _, e := range s.Values() {
+		if !other.Has(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// IsSubset tell if all elements are on other set
+func (s *Set) IsSubset(other *Set) bool {
+	for _, e := range s.Values() {
+		if !other.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal tell if both sets have same elements regardless of their order
+func (s *Set) Equal(other *Set) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Set) Clone() Object {
+	result, _ := NewSet(s.Values()...)
+	return result
+}
+
+// Freeze make set read-only, elements are already immutable
+func (s *Set) Freeze() { s.frozen = true }
+
+func (s *Set) IsFrozen() bool { return s.frozen }
+
+func (s *Set) Call(method string, args ...Object) Object {
+	switch method {
+	case "type":
+		err := Check(
+			"set.type", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &String{Value: SET_OBJ}
+	case "length":
+		err := Check(
+			"set.length", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Integer{Value: int64(s.Len())}
+	case "add":
+		err := Check(
+			"set.add", args,
+			MinimumArgs(1),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		if s.frozen {
+			return NewErrorFormat("TypeError: set.add() cannot modify frozen set")
+		}
+
+		for _, e := range args {
+			if err := s.Add(e); err != nil {
+				return NewErrorFormat("TypeError: set.add() %s", err)
+			}
+		}
+		return s
+	case "remove":
+		err := Check(
+			"set.remove", args,
+			ExactArgs(1),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+
+		if s.frozen {
+			return NewErrorFormat("TypeError: set.remove() cannot modify frozen set")
+		}
+		return nativeBoolToBooleanObject(s.Remove(args[0]))
+	case "has":
+		err := Check(
+			"set.has", args,
+			ExactArgs(1),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return nativeBoolToBooleanObject(s.Has(args[0]))
+	case "values":
+		err := Check(
+			"set.values", args,
+			ExactArgs(0),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return &Array{Elements: s.Values()}
+	case "union":
+		return setOperation("set.union", s.Union, args...)
+	case "intersection":
+		return setOperation("set.intersection", s.Intersection, args...)
+	case "difference":
+		return setOperation("set.difference", s.Difference, args...)
+	case "isSubset":
+		err := Check(
+			"set.isSubset", args,
+			ExactArgs(1),
+			WithTypes(SET_OBJ),
+		)
+
+		if err != nil {
+			return NewError(err.Error())
+		}
+		return nativeBoolToBooleanObject(s.IsSubset(args[0].(*Set)))
+	}
+	return NewErrorFormat("method %s not exists on set object.", method)
+}
+
+func setOperation(name string, operation func(other *Set) *Set, args ...Object) Object {
+	err := Check(
+		name, args,
+		ExactArgs(1),
+		WithTypes(SET_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+	return operation(args[0].(*Set))
+}
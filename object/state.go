@@ -1,17 +1,31 @@
 package object
 
-import "io"
+import (
+	"io"
+	"os"
+)
 
 var (
 	// Arguments is argument that is passed from CLI arguments
 	Arguments []string
 
 	// StandardInput where is standard input
-	StandardInput io.Reader
+	StandardInput io.Reader = os.Stdin
+
+	// StandardOutput where builtins write their output, e.g.: puts
+	StandardOutput io.Writer = os.Stdout
 
-	// StandardOutput where is standard output
-	StandardOutput io.Writer
+	// StandardError where builtins write errors, e.g.: eputs
+	StandardError io.Writer = os.Stderr
+
+	// FileSystemRoot when it isn't empty, fs module can only access paths
+	// inside of it
+	FileSystemRoot string
 
 	// ExitFunction where function responsible for exit
 	ExitFunction func(int)
+
+	// ApplyFunction call a function or builtin with arguments, it is set by
+	// evaluator so objects can call back ninja functions (e.g.: array.map)
+	ApplyFunction func(fn Object, args []Object) Object
 )
@@ -1,9 +1,13 @@
 package object
 
 import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 	"hash/fnv"
 	"strconv"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -22,7 +26,7 @@ func (s *String) HashKey() HashKey {
 		s.hashKeyCached = h.Sum64()
 	}
 
-	return HashKey{Type: s.Type(), Value: s.hashKeyCached}
+	return HashKey{Type: s.Type(), Value: s.hashKeyCached, Data: s.Value}
 }
 
 func (s *String) Compare(right Object) int8 {
@@ -81,6 +85,54 @@ func (s *String) Call(method string, args ...Object) Object {
 		return stringInteger(s.Value, args...)
 	case "float":
 		return stringFloat(s.Value, args...)
+	case "slice":
+		return stringSlice(s.Value, args...)
+	case "chars":
+		return stringChars(s.Value, args...)
+	case "normalize":
+		return stringNormalize(s.Value, args...)
+	case "fold":
+		return stringFold(s.Value, args...)
+	case "equalFold":
+		return stringEqualFold(s.Value, args...)
+	case "codepoint":
+		return stringCodepoint(s.Value, args...)
+	case "startsWith":
+		return stringStartsWith(s.Value, args...)
+	case "endsWith":
+		return stringEndsWith(s.Value, args...)
+	case "repeat":
+		return stringRepeat(s.Value, args...)
+	case "padLeft":
+		return stringPad("string.padLeft", s.Value, true, args...)
+	case "padRight":
+		return stringPad("string.padRight", s.Value, false, args...)
+	case "reverse":
+		return stringReverse(s.Value, args...)
+	case "substr":
+		return stringSubstr(s.Value, args...)
+	case "lastIndex":
+		return stringLastIndex(s.Value, args...)
+	case "count":
+		return stringCount(s.Value, args...)
+	case "trimLeft":
+		return stringTrimSide("string.trimLeft", s.Value, strings.TrimLeft, args...)
+	case "trimRight":
+		return stringTrimSide("string.trimRight", s.Value, strings.TrimRight, args...)
+	case "lines":
+		return stringLines(s.Value, args...)
+	case "words":
+		return stringWords(s.Value, args...)
+	case "title":
+		return stringTitle(s.Value, args...)
+	case "capitalize":
+		return stringCapitalize(s.Value, args...)
+	case "format":
+		return stringFormat(s.Value, args...)
+	case "isNumeric":
+		return stringIsEvery("string.isNumeric", s.Value, unicode.IsDigit, args...)
+	case "isAlpha":
+		return stringIsEvery("string.isAlpha", s.Value, unicode.IsLetter, args...)
 	}
 	return NewErrorFormat("method %s not exists on string object.", method)
 }
@@ -171,7 +223,10 @@ func stringIndex(str string, args ...Object) Object {
 	needle, _ := args[0].(*String)
 
 	val := strings.Index(str, needle.Value)
-	return &Integer{Value: int64(val)}
+	if val < 0 {
+		return &Integer{Value: -1}
+	}
+	return &Integer{Value: int64(utf8.RuneCountInString(str[:val]))}
 }
 
 func stringUpper(str string, args ...Object) Object {
@@ -253,3 +308,538 @@ func stringFloat(str string, args ...Object) Object {
 	}
 	return &Float{Value: val}
 }
+
+// stringSlice get runes from start until end (exclusive), negative positions
+// count from end of string.
+func stringSlice(str string, args ...Object) Object {
+	err := Check(
+		"string.slice",
+		args,
+		RangeOfArgs(1, 2),
+		WithTypes(INTEGER_OBJ, INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	runes := []rune(str)
+	start := runePosition(args[0].(*Integer).Value, len(runes))
+	end := len(runes)
+	if len(args) == 2 {
+		end = runePosition(args[1].(*Integer).Value, len(runes))
+	}
+
+	if start >= end {
+		return &String{Value: ""}
+	}
+	return &String{Value: string(runes[start:end])}
+}
+
+// runePosition clamp position to string boundaries
+func runePosition(position int64, length int) int {
+	if position < 0 {
+		position += int64(length)
+	}
+
+	if position < 0 {
+		return 0
+	}
+
+	if position > int64(length) {
+		return length
+	}
+	return int(position)
+}
+
+func stringChars(str string, args ...Object) Object {
+	err := Check(
+		"string.chars",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	graphemes := Graphemes(str)
+	elements := make([]Object, len(graphemes))
+	for i, g := range graphemes {
+		elements[i] = &String{Value: g}
+	}
+	return &Array{Elements: elements}
+}
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+func stringNormalize(str string, args ...Object) Object {
+	err := Check(
+		"string.normalize",
+		args,
+		RangeOfArgs(0, 1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	form := norm.NFC
+	if len(args) == 1 {
+		name := args[0].(*String).Value
+		f, ok := normalizationForms[strings.ToUpper(name)]
+		if !ok {
+			return NewErrorFormat("ValueError: string.normalize() unknown form %s, expected one of NFC, NFD, NFKC or NFKD", name)
+		}
+		form = f
+	}
+
+	return &String{Value: form.String(str)}
+}
+
+func stringFold(str string, args ...Object) Object {
+	err := Check(
+		"string.fold",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return &String{Value: cases.Fold().String(str)}
+}
+
+// stringEqualFold compare strings ignoring case, after normalizing them
+func stringEqualFold(str string, args ...Object) Object {
+	err := Check(
+		"string.equalFold",
+		args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	fold := cases.Fold()
+	other := args[0].(*String).Value
+	left := norm.NFC.String(fold.String(norm.NFD.String(str)))
+	right := norm.NFC.String(fold.String(norm.NFD.String(other)))
+
+	if left == right {
+		return TRUE
+	}
+	return FALSE
+}
+
+// stringCodepoint get unicode code point of rune at position, first one by default
+func stringCodepoint(str string, args ...Object) Object {
+	err := Check(
+		"string.codepoint",
+		args,
+		RangeOfArgs(0, 1),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	var position int64
+	if len(args) == 1 {
+		position = args[0].(*Integer).Value
+	}
+
+	runes := []rune(str)
+	if position < 0 || position >= int64(len(runes)) {
+		return NewErrorFormat("IndexError: string.codepoint() position %d out of range of string with length %d", position, len(runes))
+	}
+	return &Integer{Value: int64(runes[position])}
+}
+
+func stringStartsWith(str string, args ...Object) Object {
+	err := Check(
+		"string.startsWith",
+		args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return nativeBoolToBooleanObject(strings.HasPrefix(str, args[0].(*String).Value))
+}
+
+func stringEndsWith(str string, args ...Object) Object {
+	err := Check(
+		"string.endsWith",
+		args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return nativeBoolToBooleanObject(strings.HasSuffix(str, args[0].(*String).Value))
+}
+
+// MaxStringLength is largest string, in bytes, which methods like repeat and
+// padLeft are allowed to build
+const MaxStringLength = 1 << 30
+
+func stringRepeat(str string, args ...Object) Object {
+	err := Check(
+		"string.repeat",
+		args,
+		ExactArgs(1),
+		WithTypes(INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	count := args[0].(*Integer).Value
+	if count < 0 {
+		return NewErrorFormat("ValueError: string.repeat() count must not be negative got %d", count)
+	}
+
+	if len(str) > 0 && count > int64(MaxStringLength/len(str)) {
+		return NewErrorFormat("ValueError: string.repeat() result would be larger than %d bytes", MaxStringLength)
+	}
+	return &String{Value: strings.Repeat(str, int(count))}
+}
+
+// stringPad fill string with pad (space by default) until it has width runes
+func stringPad(name string, str string, left bool, args ...Object) Object {
+	err := Check(
+		name,
+		args,
+		RangeOfArgs(1, 2),
+		WithTypes(INTEGER_OBJ, STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	pad := " "
+	if len(args) == 2 {
+		pad = args[1].(*String).Value
+	}
+
+	if pad == "" {
+		return NewErrorFormat("ValueError: %s() pad must not be empty", name)
+	}
+
+	width := args[0].(*Integer).Value
+	length := int64(utf8.RuneCountInString(str))
+	if width <= length {
+		return &String{Value: str}
+	}
+
+	missing := width - length
+
+	padRunes := []rune(pad)
+	times := missing / int64(len(padRunes))
+	if times >= int64(MaxStringLength/len(pad)) {
+		return NewErrorFormat("ValueError: %s() result would be larger than %d bytes", name, MaxStringLength)
+	}
+
+	fill := strings.Repeat(pad, int(times)) + string(padRunes[:missing%int64(len(padRunes))])
+	if left {
+		return &String{Value: fill + str}
+	}
+	return &String{Value: str + fill}
+}
+
+// stringReverse reverse user perceived characters, so combining marks and
+// emojis keep together
+func stringReverse(str string, args ...Object) Object {
+	err := Check(
+		"string.reverse",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	graphemes := Graphemes(str)
+	out := strings.Builder{}
+	for i := len(graphemes) - 1; i >= 0; i-- {
+		out.WriteString(graphemes[i])
+	}
+	return &String{Value: out.String()}
+}
+
+// stringSubstr get length runes from start, negative start count from end of
+// string and without length it goes until end.
+func stringSubstr(str string, args ...Object) Object {
+	err := Check(
+		"string.substr",
+		args,
+		RangeOfArgs(1, 2),
+		WithTypes(INTEGER_OBJ, INTEGER_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	runes := []rune(str)
+	start := runePosition(args[0].(*Integer).Value, len(runes))
+	end := len(runes)
+	if len(args) == 2 {
+		length := args[1].(*Integer).Value
+		if length < 0 {
+			return NewErrorFormat("ValueError: string.substr() length must not be negative got %d", length)
+		}
+
+		if length < int64(end-start) {
+			end = start + int(length)
+		}
+	}
+	return &String{Value: string(runes[start:end])}
+}
+
+func stringLastIndex(str string, args ...Object) Object {
+	err := Check(
+		"string.lastIndex",
+		args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	val := strings.LastIndex(str, args[0].(*String).Value)
+	if val < 0 {
+		return &Integer{Value: -1}
+	}
+	return &Integer{Value: int64(utf8.RuneCountInString(str[:val]))}
+}
+
+func stringCount(str string, args ...Object) Object {
+	err := Check(
+		"string.count",
+		args,
+		ExactArgs(1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return &Integer{Value: int64(strings.Count(str, args[0].(*String).Value))}
+}
+
+// stringTrimSide remove runes of cutset from one side of string, by default
+// it remove whitespaces like string.trim()
+func stringTrimSide(name string, str string, trim func(string, string) string, args ...Object) Object {
+	err := Check(
+		name,
+		args,
+		RangeOfArgs(0, 1),
+		WithTypes(STRING_OBJ),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	cutset := "\n\r\t "
+	if len(args) == 1 {
+		cutset = args[0].(*String).Value
+	}
+	return &String{Value: trim(str, cutset)}
+}
+
+// stringLines split string by line breaks (\n or \r\n), last line break doesn't
+// produce an empty line
+func stringLines(str string, args ...Object) Object {
+	err := Check(
+		"string.lines",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	elements := []Object{}
+	if str == "" {
+		return &Array{Elements: elements}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(str, "\n"), "\n")
+	for _, line := range lines {
+		elements = append(elements, &String{Value: strings.TrimSuffix(line, "\r")})
+	}
+	return &Array{Elements: elements}
+}
+
+func stringWords(str string, args ...Object) Object {
+	err := Check(
+		"string.words",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	words := strings.Fields(str)
+	elements := make([]Object, len(words))
+	for i, w := range words {
+		elements[i] = &String{Value: w}
+	}
+	return &Array{Elements: elements}
+}
+
+func stringTitle(str string, args ...Object) Object {
+	err := Check(
+		"string.title",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	return &String{Value: cases.Title(language.Und).String(str)}
+}
+
+// stringCapitalize upper first letter and lower remaining ones
+func stringCapitalize(str string, args ...Object) Object {
+	err := Check(
+		"string.capitalize",
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	first, size := utf8.DecodeRuneInString(str)
+	if size == 0 {
+		return &String{Value: str}
+	}
+	return &String{Value: string(unicode.ToUpper(first)) + strings.ToLower(str[size:])}
+}
+
+// stringFormat replace placeholders by arguments, "{}" take next argument, "{0}"
+// take argument by position and "{name}" take value from a hash argument.
+// Braces are escaped by doubling them, e.g.: "{{}}"
+func stringFormat(str string, args ...Object) Object {
+	out := strings.Builder{}
+	next := 0
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c == '}' {
+			if i+1 < len(str) && str[i+1] == '}' {
+				i++
+			}
+			out.WriteByte(c)
+			continue
+		}
+
+		if c != '{' {
+			out.WriteByte(c)
+			continue
+		}
+
+		if i+1 < len(str) && str[i+1] == '{' {
+			out.WriteByte(c)
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(str[i:], '}')
+		if end < 0 {
+			return NewErrorFormat("ValueError: string.format() unclosed placeholder at %d", i)
+		}
+
+		placeholder := str[i+1 : i+end]
+		i += end
+
+		value, err := formatArgument(placeholder, &next, args)
+		if err != nil {
+			return err
+		}
+		out.WriteString(value.Inspect())
+	}
+	return &String{Value: out.String()}
+}
+
+func formatArgument(placeholder string, next *int, args []Object) (Object, *Error) {
+	if placeholder == "" {
+		if *next >= len(args) {
+			return nil, NewErrorFormat("IndexError: string.format() placeholder #%d out of range of %d arguments", *next, len(args))
+		}
+		*next++
+		return args[*next-1], nil
+	}
+
+	if position, err := strconv.Atoi(placeholder); err == nil {
+		if position < 0 || position >= len(args) {
+			return nil, NewErrorFormat("IndexError: string.format() placeholder {%d} out of range of %d arguments", position, len(args))
+		}
+		return args[position], nil
+	}
+
+	if len(args) > 0 {
+		if hash, ok := args[0].(*Hash); ok {
+			key := &String{Value: placeholder}
+			if pair, ok := hash.Get(key.HashKey()); ok {
+				return pair.Value, nil
+			}
+		}
+	}
+	return nil, NewErrorFormat("KeyError: string.format() missing value for placeholder {%s}", placeholder)
+}
+
+// stringIsEvery tell if string isn't empty and every rune satisfy predicate
+func stringIsEvery(name string, str string, predicate func(rune) bool, args ...Object) Object {
+	err := Check(
+		name,
+		args,
+		ExactArgs(0),
+	)
+
+	if err != nil {
+		return NewError(err.Error())
+	}
+
+	if str == "" {
+		return FALSE
+	}
+
+	for _, r := range str {
+		if !predicate(r) {
+			return FALSE
+		}
+	}
+	return TRUE
+}
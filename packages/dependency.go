@@ -0,0 +1,49 @@
+package packages
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Dependency is a package declared on manifest. Source may pin a revision
+// after a "#", e.g.: "https://example.com/helpers.git#v1.0.0"
+type Dependency struct {
+	Name   string
+	Source string
+	Ref    string
+}
+
+// ParseDependency split source declared on manifest from revision
+func ParseDependency(name string, spec string) (Dependency, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return Dependency{}, fmt.Errorf("invalid package name %q", name)
+	}
+
+	source, ref, _ := strings.Cut(spec, "#")
+	if source == "" {
+		return Dependency{}, fmt.Errorf("package %s don't declare a source", name)
+	}
+
+	return Dependency{Name: name, Source: source, Ref: ref}, nil
+}
+
+// Spec is how dependency is declared on manifest
+func (d Dependency) Spec() string {
+	if d.Ref == "" {
+		return d.Source
+	}
+	return d.Source + "#" + d.Ref
+}
+
+// location get source of dependency, local paths are relative to project root
+func (d Dependency) location(root string) string {
+	if isRemote(d.Source) || filepath.IsAbs(d.Source) {
+		return d.Source
+	}
+	return filepath.Join(root, d.Source)
+}
+
+func isRemote(source string) bool {
+	return strings.Contains(source, "://") || strings.HasPrefix(source, "git@")
+}
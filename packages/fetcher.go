@@ -0,0 +1,89 @@
+package packages
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Fetcher get content of a dependency from where it is declared
+type Fetcher interface {
+	// Supports tell if fetcher knows how to get source
+	Supports(source string) bool
+
+	// Fetch copy source at revision ref into dest, an empty ref means latest
+	// revision. It returns revision which was fetched, if source has one.
+	Fetch(source string, ref string, dest string) (string, error)
+}
+
+// LocalFetcher copy dependencies from a directory on local file system
+type LocalFetcher struct{}
+
+func (f *LocalFetcher) Supports(source string) bool {
+	return !isRemote(source)
+}
+
+func (f *LocalFetcher) Fetch(source string, ref string, dest string) (string, error) {
+	if ref != "" {
+		return "", fmt.Errorf("local package %s can't be pinned to revision %s", source, ref)
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.IsDir() {
+		return "", fmt.Errorf("local package %s must be a directory", source)
+	}
+
+	return "", copyDir(source, dest)
+}
+
+// copyDir copy files of src into dest, git metadata and installed
+// dependencies of package aren't copied.
+func copyDir(src string, dest string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if rel != "." && (d.Name() == ".git" || rel == VendorDir) {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(filepath.Join(dest, rel), 0755)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(path, filepath.Join(dest, rel))
+	})
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
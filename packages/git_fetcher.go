@@ -0,0 +1,70 @@
+package packages
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// GitFetcher clone dependencies from git repositories, it uses git binary
+// installed on system.
+type GitFetcher struct {
+	Command string // git binary, "git" when empty
+}
+
+// Supports sources which are URLs, ssh addresses or paths to bare repositories
+func (f *GitFetcher) Supports(source string) bool {
+	return isRemote(source) || strings.HasSuffix(source, ".git")
+}
+
+// Fetch clone source into dest and checkout ref. Source and ref come from
+// manifests, so they are never allowed to look like options of git.
+func (f *GitFetcher) Fetch(source string, ref string, dest string) (string, error) {
+	if strings.HasPrefix(source, "-") {
+		return "", fmt.Errorf("invalid git source %q", source)
+	}
+
+	if strings.HasPrefix(ref, "-") {
+		return "", fmt.Errorf("invalid git revision %q", ref)
+	}
+
+	if _, err := f.git("", "clone", "--quiet", "--", source, dest); err != nil {
+		return "", err
+	}
+
+	if ref != "" {
+		// "--" after ref tell git that ref isn't a path
+		if _, err := f.git(dest, "checkout", "--quiet", ref, "--"); err != nil {
+			return "", err
+		}
+	}
+
+	revision, err := f.git(dest, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	return revision, os.RemoveAll(filepath.Join(dest, ".git"))
+}
+
+func (f *GitFetcher) git(dir string, args ...string) (string, error) {
+	command := f.Command
+	if command == "" {
+		command = "git"
+	}
+
+	cmd := exec.Command(command, args...)
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,47 @@
+package packages
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGitFetcherRejectOptionLikeSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	tmp := t.TempDir()
+	marker := filepath.Join(tmp, "executed")
+	source := "--upload-pack=touch " + marker + ";.git"
+
+	_, err := (&GitFetcher{}).Fetch(source, "", filepath.Join(tmp, "dest"))
+	if err == nil || !strings.Contains(err.Error(), "invalid git source") {
+		t.Errorf("Fetch expected to reject source %s. Got: %v", source, err)
+	}
+
+	if _, err := os.Stat(marker); err == nil {
+		t.Errorf("source expected to not be used as git option")
+	}
+}
+
+func TestGitFetcherRejectOptionLikeRef(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	tmp := t.TempDir()
+	bare := newBareRepository(t, tmp, "strings", `export var version = 1;`)
+	dest := filepath.Join(tmp, "dest")
+
+	_, err := (&GitFetcher{}).Fetch(bare, "--orphan=evil", dest)
+	if err == nil || !strings.Contains(err.Error(), "invalid git revision") {
+		t.Errorf("Fetch expected to reject ref --orphan=evil. Got: %v", err)
+	}
+
+	if _, err := os.Stat(dest); err == nil {
+		t.Errorf("repository expected to not be cloned when ref is invalid")
+	}
+}
@@ -0,0 +1,212 @@
+package packages
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Installer install dependencies declared on manifest of project at Root into
+// it is vendor directory.
+type Installer struct {
+	Root     string
+	Fetchers []Fetcher // first fetcher which supports source is used
+}
+
+func NewInstaller(root string) *Installer {
+	return &Installer{Root: root, Fetchers: []Fetcher{&GitFetcher{}, &LocalFetcher{}}}
+}
+
+// Add declare a new dependency and install it, manifest is only changed when
+// install succeed
+func (in *Installer) Add(name string, spec string) (*Lock, error) {
+	if _, err := ParseDependency(name, spec); err != nil {
+		return nil, err
+	}
+
+	manifest, err := ReadManifest(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := ReadLock(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Dependencies[name] = spec
+	lock, err := in.installManifest(manifest, locked)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manifest.Write(in.Root); err != nil {
+		return nil, err
+	}
+	return lock, lock.Write(in.Root)
+}
+
+// Install fetch every dependency declared on manifest. Dependencies already on
+// lockfile are installed at locked revision and must match locked content
+// hash. Packages which aren't declared anymore are removed from vendor.
+func (in *Installer) Install() (*Lock, error) {
+	manifest, err := ReadManifest(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := ReadLock(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := in.installManifest(manifest, locked)
+	if err != nil {
+		return nil, err
+	}
+	return lock, lock.Write(in.Root)
+}
+
+// Update install given dependencies, or all of them when none is given,
+// ignoring what is locked, so their latest content is fetched and locked
+// again, e.g.: after a local dependency change.
+func (in *Installer) Update(names ...string) (*Lock, error) {
+	manifest, err := ReadManifest(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := ReadLock(in.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		names = sortedNames(manifest.Dependencies)
+	}
+
+	for _, name := range names {
+		if _, ok := manifest.Dependencies[name]; !ok {
+			return nil, fmt.Errorf("%s isn't declared on %s", name, ManifestFile)
+		}
+		delete(locked.Dependencies, name)
+	}
+
+	lock, err := in.installManifest(manifest, locked)
+	if err != nil {
+		return nil, err
+	}
+	return lock, lock.Write(in.Root)
+}
+
+// installManifest install dependencies of manifest into vendor and get lock
+// of what was installed, without writing it.
+func (in *Installer) installManifest(manifest *Manifest, locked *Lock) (*Lock, error) {
+	vendor := filepath.Join(in.Root, VendorDir)
+	if err := os.MkdirAll(vendor, 0755); err != nil {
+		return nil, err
+	}
+
+	lock := NewLock()
+	for _, name := range sortedNames(manifest.Dependencies) {
+		dep, err := ParseDependency(name, manifest.Dependencies[name])
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := in.install(dep, locked.Dependencies[name], vendor)
+		if err != nil {
+			return nil, fmt.Errorf("installing %s: %w", name, err)
+		}
+		lock.Dependencies[name] = entry
+	}
+
+	if err := prune(vendor, manifest.Dependencies); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+func (in *Installer) install(dep Dependency, locked LockedDependency, vendor string) (LockedDependency, error) {
+	source := dep.location(in.Root)
+	fetcher, ok := in.fetcher(source)
+	if !ok {
+		return LockedDependency{}, fmt.Errorf("no fetcher supports source %s", dep.Source)
+	}
+
+	isLocked := locked.Source == dep.Spec()
+	ref := dep.Ref
+	if isLocked && locked.Revision != "" {
+		ref = locked.Revision
+	}
+
+	tmp, err := os.MkdirTemp(vendor, ".fetch-")
+	if err != nil {
+		return LockedDependency{}, err
+	}
+	defer os.RemoveAll(tmp)
+
+	fetched := filepath.Join(tmp, dep.Name)
+	revision, err := fetcher.Fetch(source, ref, fetched)
+	if err != nil {
+		return LockedDependency{}, err
+	}
+
+	hash, err := HashDir(fetched)
+	if err != nil {
+		return LockedDependency{}, err
+	}
+
+	if isLocked && locked.Hash != hash {
+		return LockedDependency{}, fmt.Errorf("content hash mismatch, expected %s got %s, run ninja update %s if change is expected", locked.Hash, hash, dep.Name)
+	}
+
+	dest := filepath.Join(vendor, dep.Name)
+	if err := os.RemoveAll(dest); err != nil {
+		return LockedDependency{}, err
+	}
+
+	if err := os.Rename(fetched, dest); err != nil {
+		return LockedDependency{}, err
+	}
+
+	return LockedDependency{Source: dep.Spec(), Revision: revision, Hash: hash}, nil
+}
+
+func (in *Installer) fetcher(source string) (Fetcher, bool) {
+	for _, fetcher := range in.Fetchers {
+		if fetcher.Supports(source) {
+			return fetcher, true
+		}
+	}
+	return nil, false
+}
+
+// prune remove packages from vendor which aren't declared on manifest
+func prune(vendor string, dependencies map[string]string) error {
+	entries, err := os.ReadDir(vendor)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if _, ok := dependencies[entry.Name()]; ok {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(vendor, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedNames(dependencies map[string]string) []string {
+	names := make([]string, 0, len(dependencies))
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
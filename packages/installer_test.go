@@ -0,0 +1,314 @@
+package packages
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInstallLocalPackage(t *testing.T) {
+	tmp := t.TempDir()
+	helpers := filepath.Join(tmp, "helpers")
+	writeFile(t, filepath.Join(helpers, "main.ninja"), `export function double(x) { return x * 2; }`)
+	writeFile(t, filepath.Join(helpers, ".git", "HEAD"), `ref: refs/heads/main`)
+
+	root := newProject(t, tmp, map[string]string{"helpers": "../helpers"})
+
+	lock, err := NewInstaller(root).Install()
+	if err != nil {
+		t.Fatalf("Install returned error: %s", err)
+	}
+
+	assertFile(t, filepath.Join(root, VendorDir, "helpers", "main.ninja"), `export function double(x) { return x * 2; }`)
+	if _, err := os.Stat(filepath.Join(root, VendorDir, "helpers", ".git")); err == nil {
+		t.Errorf("git metadata expected to not be copied")
+	}
+
+	locked := lock.Dependencies["helpers"]
+	if locked.Source != "../helpers" || !strings.HasPrefix(locked.Hash, "sha256-") {
+		t.Errorf("lock expected to record source and hash. Got: %+v", locked)
+	}
+
+	read, err := ReadLock(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if read.Dependencies["helpers"] != locked {
+		t.Errorf("lockfile expected %+v. Got: %+v", locked, read.Dependencies["helpers"])
+	}
+}
+
+func TestInstallDetectContentChange(t *testing.T) {
+	tmp := t.TempDir()
+	helpers := filepath.Join(tmp, "helpers")
+	writeFile(t, filepath.Join(helpers, "main.ninja"), `export var version = 1;`)
+
+	root := newProject(t, tmp, map[string]string{"helpers": "../helpers"})
+	if _, err := NewInstaller(root).Install(); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, filepath.Join(helpers, "main.ninja"), `export var version = 2;`)
+
+	_, err := NewInstaller(root).Install()
+	if err == nil || !strings.Contains(err.Error(), "installing helpers: content hash mismatch") {
+		t.Fatalf("Install expected to fail with content hash mismatch. Got: %v", err)
+	}
+
+	assertFile(t, filepath.Join(root, VendorDir, "helpers", "main.ninja"), `export var version = 1;`)
+}
+
+func TestInstallUpdateLockContentChange(t *testing.T) {
+	tmp := t.TempDir()
+	helpers := filepath.Join(tmp, "helpers")
+	writeFile(t, filepath.Join(helpers, "main.ninja"), `export var version = 1;`)
+	writeFile(t, filepath.Join(tmp, "other", "main.ninja"), `export var other = 1;`)
+
+	root := newProject(t, tmp, map[string]string{"helpers": "../helpers", "other": "../other"})
+	installer := NewInstaller(root)
+	first, err := installer.Install()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, filepath.Join(helpers, "main.ninja"), `export var version = 2;`)
+
+	if _, err := installer.Update("nope"); err == nil || !strings.Contains(err.Error(), "nope isn't declared on ninja.json") {
+		t.Fatalf("Update expected to fail for unknown dependency. Got: %v", err)
+	}
+
+	lock, err := installer.Update("helpers")
+	if err != nil {
+		t.Fatalf("Update returned error: %s", err)
+	}
+
+	if lock.Dependencies["helpers"].Hash == first.Dependencies["helpers"].Hash {
+		t.Errorf("helpers expected to be locked with new hash")
+	}
+
+	if lock.Dependencies["other"] != first.Dependencies["other"] {
+		t.Errorf("other expected to keep locked entry. Got: %+v", lock.Dependencies["other"])
+	}
+
+	assertFile(t, filepath.Join(root, VendorDir, "helpers", "main.ninja"), `export var version = 2;`)
+
+	if _, err := installer.Install(); err != nil {
+		t.Errorf("Install expected to succeed after update. Got: %s", err)
+	}
+}
+
+func TestInstallAddFailureKeepManifest(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, filepath.Join(tmp, "a", "main.ninja"), `export var a = 1;`)
+
+	root := newProject(t, tmp, map[string]string{"a": "../a"})
+	installer := NewInstaller(root)
+	if _, err := installer.Install(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := installer.Add("missing", "../missing"); err == nil {
+		t.Fatalf("Add expected to fail for missing package")
+	}
+
+	manifest, _ := ReadManifest(root)
+	if _, ok := manifest.Dependencies["missing"]; ok {
+		t.Errorf("manifest expected to not declare missing. Got: %+v", manifest.Dependencies)
+	}
+
+	lock, _ := ReadLock(root)
+	if _, ok := lock.Dependencies["missing"]; ok || len(lock.Dependencies) != 1 {
+		t.Errorf("lock expected to be unchanged. Got: %+v", lock.Dependencies)
+	}
+}
+
+func TestInstallAddAndPrune(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, filepath.Join(tmp, "a", "main.ninja"), `export var a = 1;`)
+	writeFile(t, filepath.Join(tmp, "b", "main.ninja"), `export var b = 1;`)
+
+	root := newProject(t, tmp, map[string]string{"a": "../a"})
+	installer := NewInstaller(root)
+	if _, err := installer.Install(); err != nil {
+		t.Fatal(err)
+	}
+
+	lock, err := installer.Add("b", "../b")
+	if err != nil {
+		t.Fatalf("Add returned error: %s", err)
+	}
+
+	if len(lock.Dependencies) != 2 {
+		t.Errorf("lock expected to have 2 dependencies. Got: %+v", lock.Dependencies)
+	}
+
+	manifest, _ := ReadManifest(root)
+	if manifest.Dependencies["b"] != "../b" {
+		t.Errorf("manifest expected to declare b. Got: %+v", manifest.Dependencies)
+	}
+
+	delete(manifest.Dependencies, "a")
+	if err := manifest.Write(root); err != nil {
+		t.Fatal(err)
+	}
+
+	lock, err = installer.Install()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := lock.Dependencies["a"]; ok {
+		t.Errorf("a expected to be removed from lock")
+	}
+
+	if _, err := os.Stat(filepath.Join(root, VendorDir, "a")); err == nil {
+		t.Errorf("a expected to be removed from vendor")
+	}
+}
+
+func TestInstallGitPackage(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	tmp := t.TempDir()
+	bare := newBareRepository(t, tmp, "strings", `export var version = 1;`)
+	first := git(t, bare, "rev-parse", "HEAD")
+	pushCommit(t, tmp, bare, `export var version = 2;`)
+
+	root := newProject(t, tmp, map[string]string{"strings": "../strings.git"})
+	lock, err := NewInstaller(root).Install()
+	if err != nil {
+		t.Fatalf("Install returned error: %s", err)
+	}
+
+	assertFile(t, filepath.Join(root, VendorDir, "strings", "main.ninja"), `export var version = 2;`)
+	if _, err := os.Stat(filepath.Join(root, VendorDir, "strings", ".git")); err == nil {
+		t.Errorf("git metadata expected to be removed")
+	}
+
+	locked := lock.Dependencies["strings"]
+	second := git(t, bare, "rev-parse", "HEAD")
+	if locked.Revision != second {
+		t.Errorf("lock expected revision %s. Got: %s", second, locked.Revision)
+	}
+
+	// new commits aren't installed while lock pin previous revision
+	pushCommit(t, tmp, bare, `export var version = 3;`)
+	if _, err := NewInstaller(root).Install(); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, filepath.Join(root, VendorDir, "strings", "main.ninja"), `export var version = 2;`)
+
+	// pinned on manifest
+	if _, err := NewInstaller(root).Add("strings", "../strings.git#"+first); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, filepath.Join(root, VendorDir, "strings", "main.ninja"), `export var version = 1;`)
+}
+
+type fakeFetcher struct {
+	fetched []string
+}
+
+func (f *fakeFetcher) Supports(source string) bool {
+	return strings.HasPrefix(source, "fake://")
+}
+
+func (f *fakeFetcher) Fetch(source string, ref string, dest string) (string, error) {
+	f.fetched = append(f.fetched, source)
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return "", err
+	}
+	return "r1", os.WriteFile(filepath.Join(dest, "main.ninja"), []byte(source), 0644)
+}
+
+func TestInstallWithCustomFetcher(t *testing.T) {
+	root := newProject(t, t.TempDir(), map[string]string{"fake": "fake://fake"})
+
+	fetcher := &fakeFetcher{}
+	installer := &Installer{Root: root, Fetchers: []Fetcher{fetcher}}
+	lock, err := installer.Install()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fetcher.fetched) != 1 || fetcher.fetched[0] != "fake://fake" {
+		t.Errorf("fetcher expected to fetch fake://fake. Got: %v", fetcher.fetched)
+	}
+
+	if lock.Dependencies["fake"].Revision != "r1" {
+		t.Errorf("lock expected revision r1. Got: %+v", lock.Dependencies["fake"])
+	}
+
+	root = newProject(t, t.TempDir(), map[string]string{"other": "other://other"})
+	installer = &Installer{Root: root, Fetchers: []Fetcher{fetcher}}
+	if _, err := installer.Install(); err == nil || !strings.Contains(err.Error(), "no fetcher supports source other://other") {
+		t.Errorf("Install expected to fail without fetcher. Got: %v", err)
+	}
+}
+
+func newProject(t *testing.T, dir string, dependencies map[string]string) string {
+	t.Helper()
+	root := filepath.Join(dir, "project")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := NewManifest("project")
+	manifest.Dependencies = dependencies
+	if err := manifest.Write(root); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func assertFile(t *testing.T, filename string, expected string) {
+	t.Helper()
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expected file %s. Got: %s", filename, err)
+	}
+
+	if string(content) != expected {
+		t.Errorf("file %s expected %q. Got: %q", filename, expected, content)
+	}
+}
+
+// newBareRepository create a bare repository, with one commit, which packages
+// can be cloned from.
+func newBareRepository(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+	bare := filepath.Join(dir, name+".git")
+	git(t, dir, "init", "--quiet", "--bare", bare)
+	pushCommit(t, dir, bare, content)
+	return bare
+}
+
+// pushCommit commit main.ninja with content on repository
+func pushCommit(t *testing.T, dir string, bare string, content string) {
+	t.Helper()
+	work, err := os.MkdirTemp(dir, "work-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(work)
+
+	git(t, dir, "clone", "--quiet", bare, work)
+	writeFile(t, filepath.Join(work, "main.ninja"), content)
+	git(t, work, "add", "main.ninja")
+	git(t, work, "-c", "user.name=ninja", "-c", "user.email=ninja@example.com", "commit", "--quiet", "-m", "update")
+	git(t, work, "push", "--quiet", "origin", "HEAD")
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := (&GitFetcher{}).git(dir, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
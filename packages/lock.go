@@ -0,0 +1,102 @@
+package packages
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Lock is content of ninja.lock, it records what was installed for each
+// dependency, so next installs get exactly same content.
+type Lock struct {
+	Dependencies map[string]LockedDependency `json:"dependencies"`
+}
+
+type LockedDependency struct {
+	Source   string `json:"source"`             // source as declared on manifest
+	Revision string `json:"revision,omitempty"` // commit installed, for git packages
+	Hash     string `json:"hash"`               // content hash of installed files
+}
+
+func NewLock() *Lock {
+	return &Lock{Dependencies: map[string]LockedDependency{}}
+}
+
+// ReadLock read ninja.lock from dir, an empty lock is returned when project
+// wasn't installed yet.
+func ReadLock(dir string) (*Lock, error) {
+	content, err := os.ReadFile(filepath.Join(dir, LockFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewLock(), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	lock := NewLock()
+	if err := json.Unmarshal(content, lock); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filepath.Join(dir, LockFile), err)
+	}
+
+	if lock.Dependencies == nil {
+		lock.Dependencies = map[string]LockedDependency{}
+	}
+	return lock, nil
+}
+
+// Write save lock as ninja.lock on dir
+func (l *Lock) Write(dir string) error {
+	return writeJSON(filepath.Join(dir, LockFile), l)
+}
+
+// HashDir compute content hash of all files inside dir, hash only change when
+// name or content of a file change.
+func HashDir(dir string) (string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			files = append(files, path)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, file := range files {
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return "", err
+		}
+
+		content, err := os.Open(file)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "%s\x00", filepath.ToSlash(rel))
+		_, err = io.Copy(h, content)
+		content.Close()
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte{0})
+	}
+
+	return "sha256-" + hex.EncodeToString(h.Sum(nil)), nil
+}
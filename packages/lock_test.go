@@ -0,0 +1,63 @@
+package packages
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestHashDir(t *testing.T) {
+	a := t.TempDir()
+	writeFile(t, filepath.Join(a, "main.ninja"), `export var a = 1;`)
+	writeFile(t, filepath.Join(a, "lib", "b.ninja"), `export var b = 2;`)
+
+	b := t.TempDir()
+	writeFile(t, filepath.Join(b, "lib", "b.ninja"), `export var b = 2;`)
+	writeFile(t, filepath.Join(b, "main.ninja"), `export var a = 1;`)
+
+	hashA, err := HashDir(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hashB, err := HashDir(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hashA != hashB {
+		t.Errorf("directories with same content expected to have same hash. Got: %s and %s", hashA, hashB)
+	}
+
+	writeFile(t, filepath.Join(b, "main.ninja"), `export var a = 2;`)
+	changed, _ := HashDir(b)
+	if changed == hashA {
+		t.Errorf("hash expected to change when content change")
+	}
+}
+
+func TestLockReadWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	lock, err := ReadLock(dir)
+	if err != nil {
+		t.Fatalf("ReadLock without lockfile expected to succeed. Got: %s", err)
+	}
+
+	if len(lock.Dependencies) != 0 {
+		t.Errorf("lock expected to be empty. Got: %+v", lock)
+	}
+
+	lock.Dependencies["helpers"] = LockedDependency{Source: "../helpers", Hash: "sha256-00"}
+	if err := lock.Write(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadLock(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if read.Dependencies["helpers"] != lock.Dependencies["helpers"] {
+		t.Errorf("lock expected %+v. Got: %+v", lock.Dependencies["helpers"], read.Dependencies["helpers"])
+	}
+}
@@ -0,0 +1,117 @@
+package packages
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// ManifestFile is file which describe a project and it is dependencies
+	ManifestFile = "ninja.json"
+	// LockFile keep exact revision and content hash of installed dependencies
+	LockFile = "ninja.lock"
+	// VendorDir is directory, relative to project root, where dependencies are installed
+	VendorDir = "vendor"
+	// DefaultMain is file imported when a package is imported by it is name
+	DefaultMain = "main.ninja"
+)
+
+// ErrManifestExists is returned when a project is initialized twice
+var ErrManifestExists = errors.New(ManifestFile + " already exists")
+
+// Manifest is content of ninja.json. Dependencies map name of package to it is
+// source, a local path or a git repository, e.g.:
+//
+//	{"dependencies": {"helpers": "../helpers", "strings": "https://example.com/strings.git#v1.0.0"}}
+type Manifest struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Main         string            `json:"main,omitempty"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+func NewManifest(name string) *Manifest {
+	return &Manifest{Name: name, Version: "0.1.0", Dependencies: map[string]string{}}
+}
+
+// Init create a new manifest on dir, project name is taken from directory
+// when name is empty.
+func Init(dir string, name string) (*Manifest, error) {
+	if _, err := os.Stat(filepath.Join(dir, ManifestFile)); err == nil {
+		return nil, ErrManifestExists
+	}
+
+	if name == "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		name = filepath.Base(abs)
+	}
+
+	manifest := NewManifest(name)
+	return manifest, manifest.Write(dir)
+}
+
+// ReadManifest read ninja.json from dir
+func ReadManifest(dir string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filepath.Join(dir, ManifestFile), err)
+	}
+
+	if manifest.Dependencies == nil {
+		manifest.Dependencies = map[string]string{}
+	}
+	return manifest, nil
+}
+
+// Write save manifest as ninja.json on dir
+func (m *Manifest) Write(dir string) error {
+	return writeJSON(filepath.Join(dir, ManifestFile), m)
+}
+
+// Entry get file imported when package is imported by it is name
+func (m *Manifest) Entry() string {
+	if m.Main == "" {
+		return DefaultMain
+	}
+	return m.Main
+}
+
+// FindRoot search for directory with a ninja.json, starting on dir and going up
+// until root of file system.
+func FindRoot(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+
+	for {
+		if info, err := os.Stat(filepath.Join(dir, ManifestFile)); err == nil && !info.IsDir() {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+func writeJSON(filename string, value interface{}) error {
+	content, err := json.MarshalIndent(value, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(content, '\n'), 0644)
+}
@@ -0,0 +1,124 @@
+package packages
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "project")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := Init(dir, "")
+	if err != nil {
+		t.Fatalf("Init returned error: %s", err)
+	}
+
+	if manifest.Name != "project" {
+		t.Errorf("manifest name expected to be %q. Got: %q", "project", manifest.Name)
+	}
+
+	read, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatalf("ReadManifest returned error: %s", err)
+	}
+
+	if read.Name != "project" || read.Version != "0.1.0" || len(read.Dependencies) != 0 {
+		t.Errorf("manifest wasn't written as expected. Got: %+v", read)
+	}
+
+	if _, err := Init(dir, "other"); !errors.Is(err, ErrManifestExists) {
+		t.Errorf("Init twice expected to return ErrManifestExists. Got: %v", err)
+	}
+}
+
+func TestManifest_Entry(t *testing.T) {
+	tests := []struct {
+		main     string
+		expected string
+	}{
+		{"", DefaultMain},
+		{"lib.ninja", "lib.ninja"},
+	}
+
+	for _, tt := range tests {
+		manifest := &Manifest{Main: tt.main}
+		if manifest.Entry() != tt.expected {
+			t.Errorf("Entry() expected %q. Got: %q", tt.expected, manifest.Entry())
+		}
+	}
+}
+
+func TestReadManifestInvalid(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ManifestFile), `{"name": `)
+
+	if _, err := ReadManifest(dir); err == nil {
+		t.Errorf("ReadManifest expected to fail on invalid json")
+	}
+}
+
+func TestFindRoot(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, ManifestFile), `{"name": "root"}`)
+	nested := filepath.Join(root, "src", "lib")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	found, ok := FindRoot(nested)
+	if !ok {
+		t.Fatalf("FindRoot expected to find %s", root)
+	}
+
+	expected, _ := filepath.Abs(root)
+	if found != expected {
+		t.Errorf("FindRoot expected %q. Got: %q", expected, found)
+	}
+}
+
+func TestParseDependency(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		expected Dependency
+		err      bool
+	}{
+		{"helpers", "../helpers", Dependency{Name: "helpers", Source: "../helpers"}, false},
+		{"strings", "https://example.com/strings.git#v1.0.0", Dependency{Name: "strings", Source: "https://example.com/strings.git", Ref: "v1.0.0"}, false},
+		{"a/b", "../b", Dependency{}, true},
+		{"..", "../b", Dependency{}, true},
+		{"empty", "#v1", Dependency{}, true},
+	}
+
+	for _, tt := range tests {
+		dep, err := ParseDependency(tt.name, tt.spec)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseDependency(%q, %q) error expected %v. Got: %v", tt.name, tt.spec, tt.err, err)
+			continue
+		}
+
+		if dep != tt.expected {
+			t.Errorf("ParseDependency(%q, %q) expected %+v. Got: %+v", tt.name, tt.spec, tt.expected, dep)
+		}
+
+		if err == nil && dep.Spec() != tt.spec {
+			t.Errorf("Spec() expected %q. Got: %q", tt.spec, dep.Spec())
+		}
+	}
+}
+
+func writeFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,53 @@
+package packages
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Resolve find file of a vendored package imported by name from dir. Name is
+// package name, which import package entry file, or a file inside package,
+// e.g.: "helpers" or "helpers/strings.ninja"
+func Resolve(name string, dir string) (string, bool) {
+	if name == "" || filepath.IsAbs(name) || strings.HasPrefix(name, ".") {
+		return "", false
+	}
+
+	// a package installed on project vendor is visible to vendored packages too
+	for {
+		root, ok := FindRoot(dir)
+		if !ok {
+			return "", false
+		}
+
+		if path, ok := resolveVendored(name, root); ok {
+			return path, true
+		}
+		dir = filepath.Dir(root)
+		if dir == root {
+			return "", false
+		}
+	}
+}
+
+func resolveVendored(name string, root string) (string, bool) {
+	pkg, file, _ := strings.Cut(filepath.ToSlash(name), "/")
+	pkgDir := filepath.Join(root, VendorDir, pkg)
+	if info, err := os.Stat(pkgDir); err != nil || !info.IsDir() {
+		return "", false
+	}
+
+	if file == "" {
+		file = DefaultMain
+		if manifest, err := ReadManifest(pkgDir); err == nil {
+			file = manifest.Entry()
+		}
+	}
+
+	path := filepath.Join(pkgDir, filepath.FromSlash(file))
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		return "", false
+	}
+	return path, true
+}
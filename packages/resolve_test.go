@@ -0,0 +1,47 @@
+package packages
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, ManifestFile), `{"name": "project"}`)
+	writeFile(t, filepath.Join(root, VendorDir, "helpers", "main.ninja"), ``)
+	writeFile(t, filepath.Join(root, VendorDir, "helpers", "strings.ninja"), ``)
+	writeFile(t, filepath.Join(root, VendorDir, "custom", ManifestFile), `{"name": "custom", "main": "lib/custom.ninja"}`)
+	writeFile(t, filepath.Join(root, VendorDir, "custom", "lib", "custom.ninja"), ``)
+	writeFile(t, filepath.Join(root, VendorDir, "nested", ManifestFile), `{"name": "nested"}`)
+	writeFile(t, filepath.Join(root, VendorDir, "nested", "main.ninja"), `import "helpers";`)
+
+	tests := []struct {
+		name     string
+		dir      string
+		expected string
+	}{
+		{"helpers", root, "vendor/helpers/main.ninja"},
+		{"helpers/strings.ninja", root, "vendor/helpers/strings.ninja"},
+		{"custom", root, "vendor/custom/lib/custom.ninja"},
+		{"helpers", filepath.Join(root, "src"), "vendor/helpers/main.ninja"},
+		{"helpers", filepath.Join(root, VendorDir, "nested"), "vendor/helpers/main.ninja"},
+		{"missing", root, ""},
+		{"helpers/missing.ninja", root, ""},
+		{"./helpers", root, ""},
+	}
+
+	for _, tt := range tests {
+		path, ok := Resolve(tt.name, tt.dir)
+		if tt.expected == "" {
+			if ok {
+				t.Errorf("Resolve(%q) expected to not be found. Got: %s", tt.name, path)
+			}
+			continue
+		}
+
+		expected := filepath.Join(root, filepath.FromSlash(tt.expected))
+		if !ok || path != expected {
+			t.Errorf("Resolve(%q, %q) expected %s. Got: %s", tt.name, tt.dir, expected, path)
+		}
+	}
+}
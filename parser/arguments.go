@@ -27,26 +27,19 @@ func (p *Parser) parseParameterWithOptional() []ast.Expression {
 	var identifiers []ast.Expression
 	isOnRequiredParameters := true
 
-	if p.peekTokenIs(token.ASSIGN) {
-		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-		p.nextToken()
-		infix := p.parseInfixExpression(ident)
-		identifiers = append(identifiers, infix)
+	param, optional := p.parseParameter()
+	identifiers = append(identifiers, param)
+	if optional {
 		isOnRequiredParameters = false
-	} else {
-		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-		identifiers = append(identifiers, ident)
 	}
 
 	for p.peekTokenIs(token.COMMA) {
 		p.nextToken()
 		p.nextToken()
 
-		if p.peekTokenIs(token.ASSIGN) {
-			ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-			p.nextToken()
-			infix := p.parseInfixExpression(ident)
-			identifiers = append(identifiers, infix)
+		param, optional := p.parseParameter()
+		if optional {
+			identifiers = append(identifiers, param)
 			isOnRequiredParameters = false
 			continue
 		}
@@ -56,9 +49,22 @@ func (p *Parser) parseParameterWithOptional() []ast.Expression {
 			return nil
 		}
 
-		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-		identifiers = append(identifiers, ident)
+		identifiers = append(identifiers, param)
 	}
 
 	return identifiers
 }
+
+// parseParameter parse a single parameter with optional type annotation and
+// default value, e.g.: a: int = 1, it tells if parameter is optional.
+func (p *Parser) parseParameter() (ast.Expression, bool) {
+	ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	ident.Type = p.parseTypeAnnotation()
+
+	if p.peekTokenIs(token.ASSIGN) {
+		p.nextToken()
+		return p.parseInfixExpression(ident), true
+	}
+
+	return ident, false
+}
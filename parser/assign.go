@@ -17,6 +17,7 @@ func (p *Parser) parseVarStatement() *ast.VarStatement {
 	}
 
 	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	stmt.Name.Type = p.parseTypeAnnotation()
 
 	if !p.expectPeek(token.ASSIGN) {
 		return nil
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"github.com/gravataLonga/ninja/token"
+	"strings"
+	"testing"
+)
+
+func TestConstStatements(t *testing.T) {
+	tests := []struct {
+		input              string
+		expectedIdentifier string
+		expectedValue      interface{}
+	}{
+		{"const x = 5;", "x", 5},
+		{"const y = true;", "y", true},
+		{"const foobar = y;", "foobar", "y"},
+		{"const PI = 3.14", "PI", 3.14},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(strings.NewReader(tt.input))
+		p := New(l)
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		stmt, ok := program.Statements[0].(*ast.ConstStatement)
+		if !ok {
+			t.Fatalf("s not *ast.ConstStatement. got=%T", program.Statements[0])
+		}
+
+		if stmt.TokenLiteral() != "const" {
+			t.Errorf("s.TokenLiteral not 'const'. got=%q", stmt.TokenLiteral())
+		}
+
+		if !testIdentifier(t, stmt.Name, tt.expectedIdentifier) {
+			return
+		}
+
+		if !testLiteralExpression(t, stmt.Value, tt.expectedValue) {
+			return
+		}
+	}
+}
+
+func TestConstStatementErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{
+			`const x 1;`,
+			fmt.Sprintf("expected next token to be %s, got %s at [Line: 1, Offset: 10] instead.", token.ASSIGN, token.INT),
+		},
+		{
+			`const = 1;`,
+			fmt.Sprintf("expected next token to be %s, got %s at [Line: 1, Offset: 7] instead.", token.IDENT, token.ASSIGN),
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestConstStatementErrors[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			p.ParseProgram()
+
+			errors := p.Errors()
+			if len(errors) <= 0 {
+				t.Fatalf("Program don't produce any error %s", tt.input)
+			}
+
+			if errors[0] != tt.expectedError {
+				t.Errorf("expected error %q. Got: %q", tt.expectedError, errors[0])
+			}
+		})
+	}
+}
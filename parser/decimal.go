@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"math/big"
+	"strings"
+)
+
+func (p *Parser) parseDecimalLiteral() ast.Expression {
+	lit := &ast.DecimalLiteral{Token: p.curToken}
+
+	literal := strings.TrimSuffix(p.curToken.Literal, "d")
+	integer, fraction, _ := strings.Cut(literal, ".")
+	if !isSeparatedDigits(integer) || (fraction != "" && !isSeparatedDigits(fraction)) {
+		p.newError("could not parse %q as decimal", p.curToken.Literal)
+		return nil
+	}
+
+	fraction = strings.ReplaceAll(fraction, "_", "")
+	value, ok := new(big.Int).SetString(strings.ReplaceAll(integer, "_", "")+fraction, 10)
+	if !ok {
+		p.newError("could not parse %q as decimal", p.curToken.Literal)
+		return nil
+	}
+
+	lit.Value = value
+	lit.Scale = int32(len(fraction))
+	return lit
+}
+
+// isSeparatedDigits check if digits are only separated by one "_", e.g.: 1_000
+func isSeparatedDigits(digits string) bool {
+	if digits == "" || digits[0] == '_' || digits[len(digits)-1] == '_' {
+		return false
+	}
+	return !strings.Contains(digits, "__")
+}
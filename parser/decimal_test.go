@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"strings"
+	"testing"
+)
+
+func TestDecimalLiteralExpression(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedValue string
+		expectedScale int32
+	}{
+		{"12.30d", "1230", 2},
+		{"5d", "5", 0},
+		{"0.001d", "1", 3},
+		{"1_000.50d", "100050", 2},
+		{"123456789012345678901234567890.12d", "12345678901234567890123456789012", 2},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(strings.NewReader(tt.input))
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+		literal, ok := stmt.Expression.(*ast.DecimalLiteral)
+		if !ok {
+			t.Fatalf("exp not *ast.DecimalLiteral. got=%T", stmt.Expression)
+		}
+
+		if literal.Value.String() != tt.expectedValue {
+			t.Errorf("%s expected value to be %s. got=%s", tt.input, tt.expectedValue, literal.Value)
+		}
+
+		if literal.Scale != tt.expectedScale {
+			t.Errorf("%s expected scale to be %d. got=%d", tt.input, tt.expectedScale, literal.Scale)
+		}
+
+		if literal.String() != tt.input {
+			t.Errorf("literal.String() expected to be %s. got=%s", tt.input, literal.String())
+		}
+	}
+}
+
+func TestDecimalLiteralMalformed(t *testing.T) {
+	tests := []string{
+		"1__000d",
+		"1_000_d",
+		"1_.5d",
+	}
+
+	for _, input := range tests {
+		l := lexer.New(strings.NewReader(input))
+		p := New(l)
+
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%s expected to not be parsed", input)
+		}
+
+		expected := "could not parse \"" + input + "\" as decimal"
+		if errors[0] != expected {
+			t.Errorf("Error %q got=%q", expected, errors[0])
+		}
+	}
+}
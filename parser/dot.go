@@ -2,6 +2,7 @@ package parser
 
 import (
 	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/token"
 )
 
 func (p *Parser) parseDotExpression(left ast.Expression) ast.Expression {
@@ -9,6 +10,13 @@ func (p *Parser) parseDotExpression(left ast.Expression) ast.Expression {
 
 	p.nextToken()
 	fn := p.parseIdentifier()
+
+	// property access, e.g.: lib.PI
+	if !p.peekTokenIs(token.LPAREN) {
+		dotExpression.Right = fn
+		dotExpression.Object = left
+		return dotExpression
+	}
 	p.nextToken()
 
 	dotExpression.Right = p.parseCallExpression(fn)
@@ -6,7 +6,7 @@ import (
 )
 
 func (p *Parser) parseEnum() ast.Statement {
-	enum := &ast.EnumStatement{}
+	enum := &ast.EnumStatement{Token: p.curToken}
 
 	if !p.expectPeek(token.IDENT) {
 		return nil
@@ -19,44 +19,96 @@ func (p *Parser) parseEnum() ast.Statement {
 	}
 
 	branches := map[string]ast.Expression{}
+	declared := map[string]bool{}
 
 	for !p.peekTokenIs(token.RBRACE) {
-		if !p.expectPeek(token.CASE) {
-			return nil
+		if p.peekTokenIs(token.FUNCTION) {
+			p.nextToken()
+			method, ok := p.parseFunction().(*ast.FunctionLiteral)
+			if !ok || method.Name == nil {
+				p.newError("enum method must be a named function. Got: %s", p.curToken)
+				return nil
+			}
+
+			if declared[method.Name.Value] {
+				p.newError("Fatal error: Cannot redefine identifier %s", method.Name.Value)
+				return nil
+			}
+			declared[method.Name.Value] = true
+
+			enum.Methods = append(enum.Methods, method)
+			if p.peekTokenIs(token.SEMICOLON) {
+				p.nextToken()
+			}
+			continue
 		}
 
-		if !p.expectPeek(token.IDENT) {
+		enumCase := p.parseEnumCase()
+		if enumCase == nil {
 			return nil
 		}
 
-		key := p.curToken.Literal
-
-		if !p.expectPeek(token.COLON) {
+		if declared[enumCase.Name] {
+			p.newError("Fatal error: Cannot redefine identifier %s", enumCase.Name)
 			return nil
 		}
+		declared[enumCase.Name] = true
 
-		p.nextToken()
-		value := p.parseExpression(LOWEST)
-		if p.peekTokenIs(token.SEMICOLON) {
-			p.nextToken()
+		if enumCase.Value != nil {
+			branches[enumCase.Name] = enumCase.Value
 		}
+		enum.Cases = append(enum.Cases, enumCase)
+	}
+
+	p.nextToken()
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	enum.Branches = branches
+	return enum
+}
 
-		_, ok := branches[key]
-		if ok {
-			p.newError("Fatal error: Cannot redefine identifier %s", key)
+// parseEnumCase parse one of following case declaration:
+// case OK; case OK: 200; case Circle(radius);
+func (p *Parser) parseEnumCase() *ast.EnumCase {
+	if !p.expectPeek(token.CASE) {
+		return nil
+	}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	enumCase := &ast.EnumCase{Token: p.curToken, Name: p.curToken.Literal}
+
+	switch {
+	case p.peekTokenAny(token.SEMICOLON, token.RBRACE, token.CASE, token.FUNCTION):
+	case p.peekTokenIs(token.LPAREN):
+		p.nextToken()
+		enumCase.Parameters = []*ast.Identifier{}
+		for _, param := range p.parseFunctionParameters() {
+			ident, ok := param.(*ast.Identifier)
+			if !ok {
+				p.newError("enum case %s parameters must be identifiers. Got: %s", enumCase.Name, param)
+				return nil
+			}
+			enumCase.Parameters = append(enumCase.Parameters, ident)
+		}
+	default:
+		if !p.expectPeek(token.COLON) {
 			return nil
 		}
 
-		branches[key] = value
+		p.nextToken()
+		enumCase.Value = p.parseExpression(LOWEST)
 	}
 
-	p.nextToken()
 	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
 
-	enum.Branches = branches
-	return enum
+	return enumCase
 }
 
 func (p *Parser) parseEnumAccessorExpression(left ast.Expression) ast.Expression {
@@ -124,3 +124,103 @@ func TestEnumStatementWrong(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumStatementCasesAndMethods(t *testing.T) {
+	input := `enum Shape {
+	case Circle(radius);
+	case Rect(width, height);
+	case Empty;
+	case Unit: 1;
+	function area() {
+		return 0;
+	}
+}`
+
+	l := lexer.New(strings.NewReader(input))
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	enum, ok := program.Statements[0].(*ast.EnumStatement)
+	if !ok {
+		t.Fatalf("exp is not ast.EnumStatement. got=%T", program.Statements[0])
+	}
+
+	tests := []struct {
+		name       string
+		parameters []string
+		hasValue   bool
+	}{
+		{"Circle", []string{"radius"}, false},
+		{"Rect", []string{"width", "height"}, false},
+		{"Empty", nil, false},
+		{"Unit", nil, true},
+	}
+
+	if len(enum.Cases) != len(tests) {
+		t.Fatalf("enum.Cases expected %d cases. Got: %d", len(tests), len(enum.Cases))
+	}
+
+	for i, tt := range tests {
+		c := enum.Cases[i]
+		if c.Name != tt.name {
+			t.Errorf("enum.Cases[%d] expected name %s. Got: %s", i, tt.name, c.Name)
+		}
+
+		if len(c.Parameters) != len(tt.parameters) {
+			t.Fatalf("enum.Cases[%d] expected %d parameters. Got: %d", i, len(tt.parameters), len(c.Parameters))
+		}
+
+		for j, param := range tt.parameters {
+			testIdentifier(t, c.Parameters[j], param)
+		}
+
+		if (c.Value != nil) != tt.hasValue {
+			t.Errorf("enum.Cases[%d] expected value to be declared %v", i, tt.hasValue)
+		}
+	}
+
+	if len(enum.Methods) != 1 {
+		t.Fatalf("enum.Methods expected 1 method. Got: %d", len(enum.Methods))
+	}
+
+	if enum.Methods[0].Name.Value != "area" {
+		t.Errorf("enum.Methods[0] expected to be area. Got: %s", enum.Methods[0].Name.Value)
+	}
+}
+
+func TestEnumStatementCasesWrong(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{
+			`enum t { case T(a = 1); }`,
+			`enum case T parameters must be identifiers. Got: (a = 1)`,
+		},
+		{
+			`enum t { case T; function T() {} }`,
+			`Fatal error: Cannot redefine identifier T`,
+		},
+		{
+			`enum t { case T; function () {} }`,
+			`enum method must be a named function. Got: } at [Line: 1, Offset: 31]`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestEnumStatementCasesWrong[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			p.ParseProgram()
+
+			if len(p.Errors()) <= 0 {
+				t.Fatalf("expected at least 1 error. Got: 0")
+			}
+
+			if p.Errors()[0] != tt.expectedError {
+				t.Errorf("error message expected to be %s. Got: %s", tt.expectedError, p.Errors()[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+)
+
+func (p *Parser) parseExportStatement() ast.Statement {
+	stmt := &ast.ExportStatement{Token: p.curToken}
+
+	p.nextToken()
+	stmt.Statement = p.parseStatement()
+	if stmt.Statement == nil || stmt.Name() == nil {
+		p.newError("export expected a declaration of var, const, function or enum. Got: %s", p.curToken)
+		return nil
+	}
+
+	return stmt
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"strings"
+	"testing"
+)
+
+func TestExportStatement(t *testing.T) {
+	tests := []struct {
+		input        string
+		expectedName string
+		expected     string
+	}{
+		{`export var a = 1;`, "a", `export var a = 1;`},
+		{`export const PI = 3.14;`, "PI", `export const PI = 3.14;`},
+		{`export function add(a, b) { return a + b; }`, "add", `export function add(a, b) {return (a + b);}`},
+		{`export enum Color { case RED; }`, "Color", `export enumColor{RED}`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestExportStatement[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			program := p.ParseProgram()
+			checkParserErrors(t, p)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+			}
+
+			stmt, ok := program.Statements[0].(*ast.ExportStatement)
+			if !ok {
+				t.Fatalf("s not *ast.ExportStatement. got=%T", program.Statements[0])
+			}
+
+			if stmt.Name() == nil || stmt.Name().Value != tt.expectedName {
+				t.Errorf("stmt.Name() expected %q. Got: %v", tt.expectedName, stmt.Name())
+			}
+
+			if stmt.String() != tt.expected {
+				t.Errorf("stmt.String() expected %q. Got: %q", tt.expected, stmt.String())
+			}
+		})
+	}
+}
+
+func TestExportStatementErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{`export 1 + 1;`, "export expected a declaration of var, const, function or enum. Got: ; at [Line: 1, Offset: 13]"},
+		{`export function () {};`, "export expected a declaration of var, const, function or enum. Got: ; at [Line: 1, Offset: 22]"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestExportStatementErrors[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			p.ParseProgram()
+
+			if len(p.Errors()) == 0 {
+				t.Fatalf("expected parser errors. Got none")
+			}
+
+			if p.Errors()[0] != tt.expectedError {
+				t.Errorf("expected error %q. Got: %q", tt.expectedError, p.Errors()[0])
+			}
+		})
+	}
+}
@@ -18,7 +18,9 @@ func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
 func (p *Parser) parseExpression(precedence int) ast.Expression {
 	prefix, ok := p.prefixParseFns[p.curToken.Type]
 	if !ok {
-		p.noPrefixParseFnError(p.curToken.Type)
+		if !p.curTokenIsMalformed() {
+			p.noPrefixParseFnError(p.curToken.Type)
+		}
 		return nil
 	}
 
@@ -19,7 +19,7 @@ func (p *Parser) parseFunction() ast.Expression {
 
 	// Normal Function, e.g.: function add() {};
 
-	lit := &ast.FunctionLiteral{}
+	lit := &ast.FunctionLiteral{Token: p.curToken}
 	p.nextToken()
 	lit.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 
@@ -28,6 +28,7 @@ func (p *Parser) parseFunction() ast.Expression {
 	}
 
 	lit.Parameters = p.parseFunctionParameters()
+	lit.ReturnType = p.parseTypeAnnotation()
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
@@ -18,6 +18,7 @@ func (p *Parser) parseFunctionLiteral() ast.Expression {
 	}
 
 	lit.Parameters = p.parseFunctionParameters()
+	lit.ReturnType = p.parseTypeAnnotation()
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
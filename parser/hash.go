@@ -7,17 +7,23 @@ import (
 
 func (p *Parser) parseHashLiteral() ast.Expression {
 	hash := &ast.HashLiteral{Token: p.curToken}
-	hash.Pairs = make(map[ast.Expression]ast.Expression)
+	hash.Pairs = []ast.HashLiteralPair{}
 
 	for !p.peekTokenIs(token.RBRACE) {
 		p.nextToken()
 		key := p.parseExpression(LOWEST)
+
+		// first element without ":" means it is a set, e.g.: {1, 2}
+		if len(hash.Pairs) == 0 && !p.peekTokenIs(token.COLON) {
+			return p.parseSetLiteral(hash.Token, key)
+		}
+
 		if !p.expectPeek(token.COLON) {
 			return nil
 		}
 		p.nextToken()
 		value := p.parseExpression(LOWEST)
-		hash.Pairs[key] = value
+		hash.Pairs = append(hash.Pairs, ast.HashLiteralPair{Key: key, Value: value})
 		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
 			return nil
 		}
@@ -27,3 +33,26 @@ func (p *Parser) parseHashLiteral() ast.Expression {
 	}
 	return hash
 }
+
+// parseSetLiteral continue parsing of a set after it is first element
+func (p *Parser) parseSetLiteral(tok token.Token, first ast.Expression) ast.Expression {
+	set := &ast.SetLiteral{Token: tok, Elements: []ast.Expression{first}}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		if !p.expectPeek(token.COMMA) {
+			return nil
+		}
+
+		if p.peekTokenIs(token.RBRACE) {
+			break
+		}
+
+		p.nextToken()
+		set.Elements = append(set.Elements, p.parseExpression(LOWEST))
+	}
+
+	if !p.expectPeek(token.RBRACE) {
+		return nil
+	}
+	return set
+}
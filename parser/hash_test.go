@@ -30,7 +30,8 @@ func TestParsingHashLiteralsStringKeys(t *testing.T) {
 		"three": 3,
 	}
 
-	for key, value := range hash.Pairs {
+	for _, pair := range hash.Pairs {
+		key, value := pair.Key, pair.Value
 		literal, ok := key.(*ast.StringLiteral)
 		if !ok {
 			t.Errorf("key is not ast.StringLiteral. got=%T", key)
@@ -86,7 +87,8 @@ func TestParsingHashLiteralsWithExpressions(t *testing.T) {
 			testInfixExpression(t, e, 15, "/", 5)
 		},
 	}
-	for key, value := range hash.Pairs {
+	for _, pair := range hash.Pairs {
+		key, value := pair.Key, pair.Value
 		literal, ok := key.(*ast.StringLiteral)
 		if !ok {
 			t.Errorf("key is not ast.StringLiteral. got=%T", key)
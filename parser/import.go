@@ -8,11 +8,68 @@ import (
 func (p *Parser) parseImport() ast.Expression {
 	expression := &ast.Import{Token: p.curToken}
 
+	// import {a, b} from "lib.nj";
+	if p.peekTokenIs(token.LBRACE) {
+		p.nextToken()
+		expression.Names = p.parseImportNames()
+		if expression.Names == nil {
+			return nil
+		}
+
+		if !p.expectContextualKeyword("from") {
+			return nil
+		}
+	}
+
 	if !p.expectPeek(token.STRING) {
 		return nil
 	}
 
 	expression.Filename = p.parseExpression(LOWEST)
 
+	// import "lib.nj" as lib;
+	if expression.Names == nil && p.peekTokenIs(token.IDENT) && p.peekToken.Literal == "as" {
+		p.nextToken()
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		expression.Alias = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	}
+
 	return expression
 }
+
+func (p *Parser) parseImportNames() []*ast.Identifier {
+	names := []*ast.Identifier{}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		names = append(names, &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal})
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+	p.nextToken()
+
+	if len(names) == 0 {
+		p.newError("import expected at least one name %s", p.curToken)
+		return nil
+	}
+
+	return names
+}
+
+// expectContextualKeyword check if next token is an identifier with literal
+// word, words like "from" and "as" are only keywords inside import.
+func (p *Parser) expectContextualKeyword(word string) bool {
+	if p.peekTokenIs(token.IDENT) && p.peekToken.Literal == word {
+		p.nextToken()
+		return true
+	}
+
+	p.newError("expected next token to be %s, got %s instead.", word, p.peekToken)
+	return false
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"github.com/gravataLonga/ninja/lexer"
 	"strings"
 	"testing"
@@ -13,6 +14,9 @@ func TestImportStatement(t *testing.T) {
 	}{
 		{`import "testing.mod";`, "import \"testing.mod\""},
 		{`import "testing" + "other.txt";`, `import "(testing + other.txt)"`},
+		{`import "lib.nj" as lib;`, `import "lib.nj" as lib`},
+		{`import {add} from "lib.nj";`, `import {add} from "lib.nj"`},
+		{`import {add, PI,} from "lib.nj";`, `import {add, PI} from "lib.nj"`},
 	}
 
 	for _, tt := range tests {
@@ -28,3 +32,31 @@ func TestImportStatement(t *testing.T) {
 		}
 	}
 }
+
+func TestImportStatementErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{`import {add} of "lib.nj";`, "expected next token to be from, got IDENT at [Line: 1, Offset: 16] instead."},
+		{`import {} from "lib.nj";`, "import expected at least one name } at [Line: 1, Offset: 9]"},
+		{`import {1} from "lib.nj";`, "expected next token to be IDENT, got INT at [Line: 1, Offset: 10] instead."},
+		{`import "lib.nj" as 1;`, "expected next token to be IDENT, got INT at [Line: 1, Offset: 21] instead."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestImportStatementErrors[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			p.ParseProgram()
+
+			if len(p.Errors()) == 0 {
+				t.Fatalf("expected parser errors. Got none")
+			}
+
+			if p.Errors()[0] != tt.expectedError {
+				t.Errorf("expected error %q. Got: %q", tt.expectedError, p.Errors()[0])
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package parser
 
 import (
+	"errors"
 	"github.com/gravataLonga/ninja/ast"
+	"math/big"
 	"strconv"
 )
 
@@ -9,6 +11,13 @@ func (p *Parser) parseIntegerLiteral() ast.Expression {
 	lit := &ast.IntegerLiteral{Token: p.curToken}
 
 	value, err := strconv.ParseInt(p.curToken.Literal, 0, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		if bigValue, ok := new(big.Int).SetString(p.curToken.Literal, 0); ok {
+			lit.Big = bigValue
+			return lit
+		}
+	}
+
 	if err != nil {
 		p.newError("could not parse %q as integer", p.curToken.Literal)
 		return nil
@@ -56,7 +56,7 @@ func TestIntegerLiteralExpression(t *testing.T) {
 	}
 }
 
-func TestIntegerLiteralExpressionOverflowError(t *testing.T) {
+func TestIntegerLiteralExpressionOverflowIsBig(t *testing.T) {
 	input := `
 10000000000000000000;
 `
@@ -64,15 +64,78 @@ func TestIntegerLiteralExpressionOverflowError(t *testing.T) {
 	l := lexer.New(strings.NewReader(input))
 	p := New(l)
 
-	p.ParseProgram()
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	literal, ok := stmt.Expression.(*ast.IntegerLiteral)
+	if !ok {
+		t.Fatalf("exp not *ast.IntegerLiteral. got=%T", stmt.Expression)
+	}
+
+	if literal.Big == nil || literal.Big.String() != "10000000000000000000" {
+		t.Errorf("literal.Big not 10000000000000000000. got=%v", literal.Big)
+	}
+}
+
+func TestIntegerLiteralBasesAndSeparators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"0b1010", 10},
+		{"0B11", 3},
+		{"0o755", 493},
+		{"0O17", 15},
+		{"0xFF", 255},
+		{"1_000_000", 1000000},
+		{"0b1111_0000", 240},
+		{"0xFF_FF", 65535},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(strings.NewReader(tt.input))
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
 
-	if len(p.Errors()) <= 0 {
-		t.Fatalf("program not caught overflow int.")
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+		literal, ok := stmt.Expression.(*ast.IntegerLiteral)
+		if !ok {
+			t.Fatalf("exp not *ast.IntegerLiteral. got=%T", stmt.Expression)
+		}
+
+		if literal.Value != tt.expected {
+			t.Errorf("%s expected to be %d. got=%d", tt.input, tt.expected, literal.Value)
+		}
 	}
+}
 
-	errors := p.Errors()
+func TestIntegerLiteralMalformed(t *testing.T) {
+	tests := []string{
+		"0b102",
+		"0o8",
+		"1__000",
+		"1_000_",
+		"0x",
+		"0b_",
+	}
+
+	for _, input := range tests {
+		l := lexer.New(strings.NewReader(input))
+		p := New(l)
+
+		p.ParseProgram()
 
-	if errors[0] != "could not parse \"10000000000000000000\" as integer" {
-		t.Errorf("Error \"%s\" got=%q", "could not parse \"10000000000000000000\" as integer", errors[0])
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%s expected to not be parsed", input)
+		}
+
+		expected := "could not parse \"" + input + "\" as integer"
+		if errors[0] != expected {
+			t.Errorf("Error %q got=%q", expected, errors[0])
+		}
 	}
 }
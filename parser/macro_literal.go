@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/token"
+)
+
+func (p *Parser) parseMacroLiteral() ast.Expression {
+	lit := &ast.MacroLiteral{Token: p.curToken}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	lit.Parameters = []*ast.Identifier{}
+	for _, param := range p.parseFunctionParameters() {
+		ident, ok := param.(*ast.Identifier)
+		if !ok || ident.Type != nil {
+			p.newError("macro parameters must be plain identifiers. Got: %s", param.String())
+			return nil
+		}
+		lit.Parameters = append(lit.Parameters, ident)
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	lit.Body = p.parseBlockStatement()
+
+	return lit
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gravataLonga/ninja/ast"
 	"github.com/gravataLonga/ninja/lexer"
 	"github.com/gravataLonga/ninja/token"
+	"unicode/utf8"
 )
 
 // Here we will define for each type of Token what is precedence by
@@ -76,6 +77,7 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerPrefix(token.IDENT, p.parseIdentifier, LOWEST)
 	p.registerPrefix(token.INT, p.parseIntegerLiteral, LOWEST)
 	p.registerPrefix(token.FLOAT, p.parseFloatLiteral, LOWEST)
+	p.registerPrefix(token.DECIMAL, p.parseDecimalLiteral, LOWEST)
 	p.registerPrefix(token.TRUE, p.parseBoolean, LOWEST)
 	p.registerPrefix(token.FALSE, p.parseBoolean, LOWEST)
 	p.registerPrefix(token.STRING, p.parseString, LOWEST)
@@ -91,6 +93,7 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerPrefix(token.LBRACE, p.parseHashLiteral, LOWEST)
 	p.registerPrefix(token.FOR, p.parseLoopLiteral, LOWEST)
 	p.registerPrefix(token.IMPORT, p.parseImport, LOWEST)
+	p.registerPrefix(token.MACRO, p.parseMacroLiteral, LOWEST)
 	// p.registerPrefix(token.BIT_NOT, p.parsePrefixExpression)
 
 	p.infixParseFns = make(map[token.TokenType]infixParseFn)
@@ -133,9 +136,16 @@ func New(l *lexer.Lexer) *Parser {
 	return p
 }
 
-// Errors get slice of errors
+// Errors get slice of errors, errors found by lexer come first
 func (p *Parser) Errors() []string {
-	return p.errors
+	lexerErrors := p.l.Errors()
+	if len(lexerErrors) == 0 {
+		return p.errors
+	}
+
+	errors := make([]string, 0, len(lexerErrors)+len(p.errors))
+	errors = append(errors, lexerErrors...)
+	return append(errors, p.errors...)
 }
 
 // ParseProgram is main point for hole program
@@ -159,6 +169,12 @@ func (p *Parser) newError(format string, a ...interface{}) {
 	p.errors = append(p.errors, s)
 }
 
+// curTokenIsMalformed tell if current token is malformed UTF-8, which lexer
+// already reported.
+func (p *Parser) curTokenIsMalformed() bool {
+	return p.curTokenIs(token.ILLEGAL) && !utf8.ValidString(p.curToken.Literal)
+}
+
 func (p *Parser) noPrefixParseFnError(t token.TokenType) {
 	p.newError("no prefix parse function for %s found", t)
 }
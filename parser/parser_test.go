@@ -276,6 +276,39 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 	}
 }
 
+func TestParserReportMalformedUtf8(t *testing.T) {
+	l := lexer.New(strings.NewReader("var a = \"\xff\"; \xfe;"))
+	p := New(l)
+	p.ParseProgram()
+
+	expected := []string{
+		"invalid UTF-8 encoding 0xff STRING at [Line: 1, Offset: 10]",
+		"invalid UTF-8 encoding 0xfe ILLEGAL at [Line: 1, Offset: 14]",
+	}
+
+	errors := p.Errors()
+	if len(errors) != len(expected) {
+		t.Fatalf("parser expected %d errors. Got: %v", len(expected), errors)
+	}
+
+	for i, err := range expected {
+		if errors[i] != err {
+			t.Errorf("parser error expected %q. Got: %q", err, errors[i])
+		}
+	}
+}
+
+func TestParserUnicodeIdentifiers(t *testing.T) {
+	l := lexer.New(strings.NewReader(`var café = 1; café + नमस्ते;`))
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if program.String() != "var café = 1;(café + नमस्ते)" {
+		t.Errorf("program expected %q. Got: %q", "var café = 1;(café + नमस्ते)", program.String())
+	}
+}
+
 func checkParserErrors(t *testing.T, p *Parser) {
 	errors := p.Errors()
 	if len(errors) == 0 {
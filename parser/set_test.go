@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"strings"
+	"testing"
+)
+
+func TestParsingSetLiterals(t *testing.T) {
+	input := `{1, 2 * 2, "a", true,}`
+
+	l := lexer.New(strings.NewReader(input))
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	set, ok := stmt.Expression.(*ast.SetLiteral)
+	if !ok {
+		t.Fatalf("exp not ast.SetLiteral. got=%T", stmt.Expression)
+	}
+
+	if len(set.Elements) != 4 {
+		t.Fatalf("len(set.Elements) not 4. got=%d", len(set.Elements))
+	}
+
+	testIntegerLiteral(t, set.Elements[0], 1)
+	testInfixExpression(t, set.Elements[1], 2, "*", 2)
+	testBooleanLiteral(t, set.Elements[3], true)
+
+	if set.String() != `{1, (2 * 2), a, true}` {
+		t.Errorf("set.String() wrong. got=%s", set.String())
+	}
+}
+
+func TestParsingEmptyBracesIsHash(t *testing.T) {
+	l := lexer.New(strings.NewReader(`{}`))
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt, _ := program.Statements[0].(*ast.ExpressionStatement)
+	if _, ok := stmt.Expression.(*ast.HashLiteral); !ok {
+		t.Fatalf("exp not ast.HashLiteral. got=%T", stmt.Expression)
+	}
+}
+
+func TestParsingSetLiteralsMixedWithPairs(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{`{1, 2: 3}`, "expected next token to be ,, got : at [Line: 1, Offset: 6] instead."},
+		{`{1: 2, 3}`, "expected next token to be :, got } at [Line: 1, Offset: 9] instead."},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(strings.NewReader(tt.input))
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%s expected to not be parsed", tt.input)
+		}
+
+		if errors[0] != tt.expectedError {
+			t.Errorf("Error %q got=%q", tt.expectedError, errors[0])
+		}
+	}
+}
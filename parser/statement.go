@@ -16,12 +16,16 @@ func (p *Parser) parseStatement() ast.Statement {
 		return p.parseDeleteStatement()
 	case token.VAR:
 		return p.parseVarStatement()
+	case token.CONST:
+		return p.parseConstStatement()
 	case token.RETURN:
 		return p.parseReturnStatement()
 	case token.BREAK:
 		return p.parseBreakStatement()
 	case token.ENUM:
 		return p.parseEnum()
+	case token.EXPORT:
+		return p.parseExportStatement()
 	default:
 		return p.parseExpressionStatement()
 	}
@@ -0,0 +1,24 @@
+package parser
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/token"
+)
+
+// parseTypeAnnotation parse optional type after a colon, e.g.: a: int
+// it returns nil when there isn't any annotation.
+func (p *Parser) parseTypeAnnotation() *ast.TypeAnnotation {
+	if !p.peekTokenIs(token.COLON) {
+		return nil
+	}
+	p.nextToken()
+
+	// "function" is a keyword, but it is also a valid type name
+	if !p.peekTokenIs(token.IDENT) && !p.peekTokenIs(token.FUNCTION) {
+		p.peekError(token.IDENT)
+		return nil
+	}
+	p.nextToken()
+
+	return &ast.TypeAnnotation{Token: p.curToken, Name: p.curToken.Literal}
+}
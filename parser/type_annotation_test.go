@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/lexer"
+	"github.com/gravataLonga/ninja/token"
+	"strings"
+	"testing"
+)
+
+func TestTypeAnnotation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`var x: int = 1;`, `var x: int = 1;`},
+		{`var x = 1;`, `var x = 1;`},
+		{`const NAME: string = "ninja";`, `const NAME: string = ninja;`},
+		{`function add(a: int, b: int): int { return a + b; }`, `function add(a: int, b: int): int {return (a + b);}`},
+		{`function (a, b: float): float { }`, `function(a, b: float): float {}`},
+		{`function (a: int, b: number = 2) { }`, `function(a: int, (b: number = 2)) {}`},
+		{`function (f: function): any { }`, `function(f: function): any {}`},
+		{`function (a, b = 2) { }`, `function(a, (b = 2)) {}`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestTypeAnnotation[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			program := p.ParseProgram()
+			checkParserErrors(t, p)
+
+			if program.String() != tt.expected {
+				t.Errorf("program.String() expected %q. Got: %q", tt.expected, program.String())
+			}
+		})
+	}
+}
+
+func TestTypeAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+	}{
+		{
+			`var x: = 1;`,
+			fmt.Sprintf("expected next token to be %s, got %s at [Line: 1, Offset: 8] instead.", token.IDENT, token.ASSIGN),
+		},
+		{
+			`function (a: 1) {}`,
+			fmt.Sprintf("expected next token to be %s, got %s at [Line: 1, Offset: 15] instead.", token.IDENT, token.INT),
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestTypeAnnotationErrors[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := New(l)
+			p.ParseProgram()
+
+			if len(p.Errors()) == 0 {
+				t.Fatalf("expected parser errors. Got none")
+			}
+
+			if p.Errors()[0] != tt.expectedError {
+				t.Errorf("expected error %q. Got: %q", tt.expectedError, p.Errors()[0])
+			}
+		})
+	}
+}
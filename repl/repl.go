@@ -9,6 +9,7 @@ import (
 	"github.com/gravataLonga/ninja/lexer"
 	"github.com/gravataLonga/ninja/object"
 	"github.com/gravataLonga/ninja/parser"
+	"github.com/gravataLonga/ninja/semantic"
 	"io"
 	"os"
 	"os/user"
@@ -29,6 +30,7 @@ type Repl struct {
 	in      io.Reader
 	scan    *bufio.Scanner
 	env     *object.Environment
+	macros  *object.Environment
 	version string
 }
 
@@ -42,8 +44,9 @@ var colorName = map[string]*color.Color{
 func NewRepel(out io.Writer, in io.Reader) *Repl {
 	scanner := bufio.NewScanner(in)
 	env := object.NewEnvironment()
+	macros := object.NewEnvironment()
 
-	return &Repl{out: out, in: in, scan: scanner, env: env}
+	return &Repl{out: out, in: in, scan: scanner, env: env, macros: macros}
 }
 
 func (r *Repl) Version(vs string) {
@@ -89,7 +92,21 @@ func (r *Repl) Start() {
 			continue
 		}
 
-		evaluated := evaluator.Eval(program, r.env)
+		evaluator.DefineMacros(program, r.macros)
+		expanded, errors := evaluator.ExpandMacros(program, r.macros)
+		if len(errors) != 0 {
+			r.printMacroErrors(errors)
+			continue
+		}
+
+		s := semantic.New(expanded)
+		node := s.Analysis()
+		if len(s.Errors()) != 0 {
+			r.printSemanticErrors(s.Errors())
+			continue
+		}
+
+		evaluated := evaluator.Eval(node, r.env)
 
 		if _, ok := evaluated.(*object.Error); ok {
 			r.Output("error", evaluated.Inspect())
@@ -122,6 +139,14 @@ func (r *Repl) printParserErrors(errors []string) {
 	}
 }
 
+func (r *Repl) printMacroErrors(errors []string) {
+	r.Output("error", "We got some macro errors.\n")
+	r.Output("error", "\tmacro errors:\n")
+	for _, msg := range errors {
+		r.Output("error", "\t\t"+msg+"\n")
+	}
+}
+
 func (r *Repl) printSemanticErrors(errors []string) {
 	r.Output("error", "We got some semantic errors.\n")
 	r.Output("error", "\tsemantic errors:\n")
@@ -1,19 +1,356 @@
 package semantic
 
-import "github.com/gravataLonga/ninja/ast"
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/token"
+)
 
 type Semantic struct {
 	program ast.Node
+	errors  []string
+
+	// scopes keep track of declared identifiers for each function frame
+	scopes []scope
+
+	// returns keep expected return type of each function being analysed,
+	// nil when function don't declare one.
+	returns []*ast.TypeAnnotation
 }
 
 func New(node ast.Node) *Semantic {
-	return &Semantic{program: node}
+	return &Semantic{program: node, errors: []string{}}
+}
+
+// Errors get slice of errors found during analysis
+func (s *Semantic) Errors() []string {
+	return s.errors
 }
 
 func (s *Semantic) Analysis() ast.Node {
-	return analysis(s.program)
+	s.enterScope()
+	s.analysis(s.program)
+	s.leaveScope()
+	return s.program
+}
+
+func (s *Semantic) newError(format string, a ...interface{}) {
+	s.errors = append(s.errors, fmt.Sprintf(format, a...))
+}
+
+func (s *Semantic) enterScope() {
+	s.scopes = append(s.scopes, scope{})
+}
+
+func (s *Semantic) leaveScope() {
+	s.scopes = s.scopes[:len(s.scopes)-1]
+}
+
+// declare register identifier at current scope, a constant can't be redeclared
+// on same scope.
+func (s *Semantic) declare(ident *ast.Identifier, sym *symbol) {
+	current := s.scopes[len(s.scopes)-1]
+	if declared, ok := current[ident.Value]; ok && declared.constant {
+		s.newError("cannot redeclare constant %s %s", ident.Value, ident.Token)
+		return
+	}
+	current[ident.Value] = sym
+}
+
+// resolve identifier from inner to outer scope
+func (s *Semantic) resolve(name string) (*symbol, bool) {
+	for i := len(s.scopes) - 1; i >= 0; i-- {
+		if sym, ok := s.scopes[i][name]; ok {
+			return sym, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Semantic) checkReassign(node ast.Expression, tok token.Token) {
+	ident, ok := node.(*ast.Identifier)
+	if !ok {
+		return
+	}
+
+	if sym, ok := s.resolve(ident.Value); ok && sym.constant {
+		s.newError("cannot reassign constant %s %s", ident.Value, tok)
+	}
+}
+
+// analysis walk node checking it and return type inferred for node, an empty
+// type means it is unknown.
+func (s *Semantic) analysis(node ast.Node) string {
+	switch node := node.(type) {
+	case *ast.Program:
+		for _, stmt := range node.Statements {
+			s.analysis(stmt)
+		}
+	case *ast.BlockStatement:
+		if node == nil {
+			return ""
+		}
+		for _, stmt := range node.Statements {
+			s.analysis(stmt)
+		}
+	case *ast.ExpressionStatement:
+		return s.analysis(node.Expression)
+	case *ast.VarStatement:
+		if node == nil {
+			return ""
+		}
+		valueType := s.analysis(node.Value)
+		s.checkDeclaration(node.Name, valueType)
+		s.declare(node.Name, &symbol{typ: annotationName(node.Name.Type)})
+	case *ast.ConstStatement:
+		valueType := s.analysis(node.Value)
+		s.checkDeclaration(node.Name, valueType)
+
+		// constants can't change, so it is safe to infer their type
+		sym := &symbol{constant: true, typ: valueType}
+		if node.Name.Type != nil {
+			sym.typ = node.Name.Type.Name
+		}
+		if fn, ok := node.Value.(*ast.FunctionLiteral); ok {
+			sym.fn = fn
+		}
+		s.declare(node.Name, sym)
+	case *ast.AssignStatement:
+		valueType := s.analysis(node.Value)
+		if ident, ok := node.Name.(*ast.Identifier); ok {
+			s.checkReassign(ident, ident.Token)
+			if sym, ok := s.resolve(ident.Value); ok && !sym.constant && !compatible(sym.typ, valueType) {
+				s.newError("type mismatch: cannot assign %s to %s of type %s %s", valueType, ident.Value, sym.typ, ident.Token)
+			}
+			return valueType
+		}
+		s.analysis(node.Name)
+		return valueType
+	case *ast.FunctionLiteral:
+		s.analysisFunction(node)
+		return "function"
+	case *ast.CallExpression:
+		return s.analysisCall(node)
+	case *ast.PrefixExpression:
+		if node.Operator == "++" || node.Operator == "--" {
+			s.checkReassign(node.Right, node.Token)
+		}
+		return prefixType(node.Operator, s.analysis(node.Right))
+	case *ast.PostfixExpression:
+		s.checkReassign(node.Left, node.Token)
+		return prefixType(node.Operator, s.analysis(node.Left))
+	case *ast.InfixExpression:
+		left := s.analysis(node.Left)
+		right := s.analysis(node.Right)
+		typ, ok := infixType(node.Operator, left, right)
+		if !ok {
+			s.newError("type mismatch: %s %s %s %s", left, node.Operator, right, node.Token)
+		}
+		return typ
+	case *ast.IfExpression:
+		s.analysis(node.Condition)
+		s.analysis(node.Consequence)
+		s.analysis(node.Alternative)
+	case *ast.TernaryOperatorExpression:
+		s.analysis(node.Condition)
+		consequence := s.analysis(node.Consequence)
+		alternative := s.analysis(node.Alternative)
+		if consequence == alternative {
+			return consequence
+		}
+	case *ast.ElvisOperatorExpression:
+		s.analysis(node.Left)
+		s.analysis(node.Right)
+	case *ast.ForStatement:
+		s.analysis(node.InitialCondition)
+		s.analysis(node.Condition)
+		s.analysis(node.Iteration)
+		s.analysis(node.Body)
+	case *ast.ArrayLiteral:
+		for _, el := range node.Elements {
+			s.analysis(el)
+		}
+		return "array"
+	case *ast.SetLiteral:
+		for _, el := range node.Elements {
+			s.analysis(el)
+		}
+		return "set"
+	case *ast.HashLiteral:
+		for _, pair := range node.Pairs {
+			s.analysis(pair.Key)
+			s.analysis(pair.Value)
+		}
+		return "hash"
+	case *ast.IndexExpression:
+		left := s.analysis(node.Left)
+		s.analysis(node.Index)
+		if left == "string" {
+			return "string"
+		}
+	case *ast.Dot:
+		s.analysis(node.Object)
+		s.analysis(node.Right)
+	case *ast.ReturnStatement:
+		valueType := s.analysis(node.ReturnValue)
+		s.checkReturn(node, valueType)
+	case *ast.DeleteStatement:
+		s.analysis(node.Left)
+		s.analysis(node.Index)
+	case *ast.EnumStatement:
+		s.analysisEnum(node)
+	case *ast.ScopeOperatorExpression:
+		if ident, ok := node.AccessIdentifier.(*ast.Identifier); ok {
+			if sym, ok := s.resolve(ident.Value); ok && sym.enum {
+				return ident.Value
+			}
+		}
+	case *ast.Import:
+		s.analysis(node.Filename)
+		if node.Alias != nil {
+			s.declare(node.Alias, &symbol{})
+		}
+		for _, name := range node.Names {
+			s.declare(name, &symbol{})
+		}
+	case *ast.ExportStatement:
+		if len(s.scopes) > 1 {
+			s.newError("export is only allowed at top level %s", node.Token)
+		}
+		s.analysis(node.Statement)
+	case *ast.Identifier:
+		if sym, ok := s.resolve(node.Value); ok {
+			return sym.typ
+		}
+	case *ast.IntegerLiteral:
+		return "int"
+	case *ast.FloatLiteral:
+		return "float"
+	case *ast.DecimalLiteral:
+		return "decimal"
+	case *ast.StringLiteral:
+		return "string"
+	case *ast.Boolean:
+		return "bool"
+	}
+	return ""
 }
 
-func analysis(node ast.Node) ast.Node {
-	return node
+func (s *Semantic) analysisFunction(node *ast.FunctionLiteral) {
+	if node.Name != nil {
+		s.declare(node.Name, &symbol{fn: node})
+	}
+	s.checkAnnotation(node.ReturnType)
+
+	s.enterScope()
+	for _, param := range node.Parameters {
+		switch param := param.(type) {
+		case *ast.Identifier:
+			s.checkAnnotation(param.Type)
+			s.declare(param, &symbol{typ: annotationName(param.Type)})
+		case *ast.InfixExpression:
+			defaultType := s.analysis(param.Right)
+			if ident, ok := param.Left.(*ast.Identifier); ok {
+				s.checkDeclaration(ident, defaultType)
+				s.declare(ident, &symbol{typ: annotationName(ident.Type)})
+			}
+		}
+	}
+
+	s.returns = append(s.returns, node.ReturnType)
+	s.analysis(node.Body)
+	s.returns = s.returns[:len(s.returns)-1]
+	s.leaveScope()
+}
+
+func (s *Semantic) analysisCall(node *ast.CallExpression) string {
+	s.analysis(node.Function)
+	args := make([]string, len(node.Arguments))
+	for i, arg := range node.Arguments {
+		args[i] = s.analysis(arg)
+	}
+
+	var fn *ast.FunctionLiteral
+	switch callee := node.Function.(type) {
+	case *ast.FunctionLiteral:
+		fn = callee
+	case *ast.Identifier:
+		if sym, ok := s.resolve(callee.Value); ok {
+			fn = sym.fn
+		}
+	case *ast.ScopeOperatorExpression:
+		// constructing enum case with associated data, e.g.: Shape::Circle(1)
+		return s.analysis(callee)
+	}
+
+	if fn == nil {
+		return ""
+	}
+
+	for i, param := range fn.Parameters {
+		ident := parameterIdentifier(param)
+		if i >= len(args) || ident == nil || ident.Type == nil {
+			continue
+		}
+		if !compatible(ident.Type.Name, args[i]) {
+			s.newError("type mismatch: argument #%d (%s) expected to be %s, got %s %s", i+1, ident.Value, ident.Type, args[i], node.Token)
+		}
+	}
+
+	return annotationName(fn.ReturnType)
+}
+
+func (s *Semantic) analysisEnum(node *ast.EnumStatement) {
+	for _, c := range node.Cases {
+		s.analysis(c.Value)
+	}
+	if ident, ok := node.Identifier.(*ast.Identifier); ok {
+		s.declare(ident, &symbol{enum: true})
+	}
+	for _, method := range node.Methods {
+		s.enterScope()
+		s.scopes[len(s.scopes)-1]["self"] = &symbol{constant: true}
+		s.analysis(&ast.FunctionLiteral{Token: method.Token, Parameters: method.Parameters, Body: method.Body, ReturnType: method.ReturnType})
+		s.leaveScope()
+	}
+}
+
+// checkAnnotation report types which aren't builtin neither declared enums
+func (s *Semantic) checkAnnotation(annotation *ast.TypeAnnotation) bool {
+	if annotation == nil || isBuiltinType(annotation.Name) {
+		return true
+	}
+
+	if sym, ok := s.resolve(annotation.Name); ok && sym.enum {
+		return true
+	}
+	s.newError("unknown type %s %s", annotation.Name, annotation.Token)
+	return false
+}
+
+// checkDeclaration check if value assigned on declaration match annotation
+func (s *Semantic) checkDeclaration(ident *ast.Identifier, valueType string) {
+	if ident.Type == nil {
+		return
+	}
+
+	if s.checkAnnotation(ident.Type) && !compatible(ident.Type.Name, valueType) {
+		s.newError("type mismatch: cannot assign %s to %s of type %s %s", valueType, ident.Value, ident.Type, ident.Token)
+	}
+}
+
+// checkReturn check if value returned match return type of current function
+func (s *Semantic) checkReturn(node *ast.ReturnStatement, valueType string) {
+	if len(s.returns) == 0 {
+		return
+	}
+
+	expected := s.returns[len(s.returns)-1]
+	if expected == nil {
+		return
+	}
+
+	if !compatible(expected.Name, valueType) {
+		s.newError("type mismatch: function expected to return %s, got %s %s", expected, valueType, node.Token)
+	}
 }
@@ -1,7 +1,11 @@
 package semantic
 
 import (
+	"fmt"
 	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/lexer"
+	"github.com/gravataLonga/ninja/parser"
+	"strings"
 	"testing"
 )
 
@@ -15,6 +19,130 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestConstantReassign(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedErrors []string
+	}{
+		{
+			`const a = 1; var b = a; b = 2;`,
+			[]string{},
+		},
+		{
+			`const a = 1; a = 2;`,
+			[]string{"cannot reassign constant a IDENT at [Line: 1, Offset: 15]"},
+		},
+		{
+			`const a = 1; var a = 2;`,
+			[]string{"cannot redeclare constant a IDENT at [Line: 1, Offset: 19]"},
+		},
+		{
+			`const a = 1; const a = 2;`,
+			[]string{"cannot redeclare constant a IDENT at [Line: 1, Offset: 21]"},
+		},
+		{
+			`const a = 1; a++;`,
+			[]string{"cannot reassign constant a ++ at [Line: 1, Offset: 16]"},
+		},
+		{
+			`const a = 1; --a;`,
+			[]string{"cannot reassign constant a -- at [Line: 1, Offset: 15]"},
+		},
+		{
+			`const a = 1; function () { a = 2; }`,
+			[]string{"cannot reassign constant a IDENT at [Line: 1, Offset: 29]"},
+		},
+		{
+			`const a = 1; function () { var a = 2; a = 3; }`,
+			[]string{},
+		},
+		{
+			`const a = 1; function (a) { a = 3; }`,
+			[]string{},
+		},
+		{
+			`const a = 1; function a() { }`,
+			[]string{"cannot redeclare constant a IDENT at [Line: 1, Offset: 24]"},
+		},
+		{
+			`const a = 1; for (var i = 0; i < 1; i++) { if (true) { a = i; } }`,
+			[]string{"cannot reassign constant a IDENT at [Line: 1, Offset: 57]"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestConstantReassign[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := parser.New(l)
+			program := p.ParseProgram()
+			if len(p.Errors()) > 0 {
+				t.Fatalf("parser errors: %v", p.Errors())
+			}
+
+			s := New(program)
+			s.Analysis()
+
+			if len(s.Errors()) != len(tt.expectedErrors) {
+				t.Fatalf("expected %d errors. Got: %d (%v)", len(tt.expectedErrors), len(s.Errors()), s.Errors())
+			}
+
+			for i, err := range tt.expectedErrors {
+				if s.Errors()[i] != err {
+					t.Errorf("expected error %q. Got: %q", err, s.Errors()[i])
+				}
+			}
+		})
+	}
+}
+
+func TestModuleDeclarations(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedErrors []string
+	}{
+		{
+			`export const a = 1; export function add(a, b) { return a + b; }`,
+			[]string{},
+		},
+		{
+			`function () { export var a = 1; }`,
+			[]string{"export is only allowed at top level EXPORT at [Line: 1, Offset: 21]"},
+		},
+		{
+			`const lib = 1; import "lib.nj" as lib;`,
+			[]string{"cannot redeclare constant lib IDENT at [Line: 1, Offset: 38]"},
+		},
+		{
+			`const add = 1; import {add} from "lib.nj";`,
+			[]string{"cannot redeclare constant add IDENT at [Line: 1, Offset: 27]"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestModuleDeclarations[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := parser.New(l)
+			program := p.ParseProgram()
+			if len(p.Errors()) > 0 {
+				t.Fatalf("parser errors: %v", p.Errors())
+			}
+
+			s := New(program)
+			s.Analysis()
+
+			if len(s.Errors()) != len(tt.expectedErrors) {
+				t.Fatalf("expected %d errors. Got: %d (%v)", len(tt.expectedErrors), len(s.Errors()), s.Errors())
+			}
+
+			for i, err := range tt.expectedErrors {
+				if s.Errors()[i] != err {
+					t.Errorf("expected error %q. Got: %q", err, s.Errors()[i])
+				}
+			}
+		})
+	}
+}
+
 /*
 func TestDeclareIdentifierRegisterHops(t *testing.T) {
 	input := `var a = 1`
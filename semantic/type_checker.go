@@ -0,0 +1,124 @@
+package semantic
+
+import (
+	"github.com/gravataLonga/ninja/ast"
+	"github.com/gravataLonga/ninja/object"
+)
+
+// symbol is what we know about a declared identifier
+type symbol struct {
+	constant bool
+	enum     bool                 // identifier is an enum, so it can be used as type
+	typ      string               // declared or inferred type, empty when unknown
+	fn       *ast.FunctionLiteral // signature of function bound to identifier, if known
+}
+
+type scope map[string]*symbol
+
+// annotationName get type name of annotation, empty when there isn't one
+func annotationName(annotation *ast.TypeAnnotation) string {
+	if annotation == nil {
+		return ""
+	}
+	return annotation.Name
+}
+
+func isBuiltinType(name string) bool {
+	return object.IsBuiltinAnnotation(name)
+}
+
+func isNumberType(name string) bool {
+	return name == "int" || name == "float" || name == "decimal" || name == "number"
+}
+
+// compatible tell if a value of type actual can be used where expected type is
+// declared, unknown types are always compatible.
+func compatible(expected, actual string) bool {
+	if expected == "" || actual == "" || expected == "any" || actual == "any" {
+		return true
+	}
+
+	if expected == actual {
+		return true
+	}
+
+	// division between integers can produce int or float, so we can't tell
+	if actual == "number" && isNumberType(expected) {
+		return true
+	}
+
+	return expected == "number" && isNumberType(actual)
+}
+
+// prefixType infer type of prefix and postfix expressions
+func prefixType(operator string, right string) string {
+	if operator == "!" {
+		return "bool"
+	}
+
+	if isNumberType(right) {
+		return right
+	}
+	return ""
+}
+
+// infixType infer type of infix expression, it tells if operands can't be
+// used together, like evaluator would do at runtime.
+func infixType(operator string, left, right string) (string, bool) {
+	switch operator {
+	case "&&", "||", "==", "!=":
+		return "bool", true
+	}
+
+	switch {
+	case isNumberType(left) && isNumberType(right):
+		return numberInfixType(operator, left, right), true
+	case left == "string" && right == "string":
+		if operator == "+" {
+			return "string", true
+		}
+	case isBuiltinType(left) && isBuiltinType(right) && left != right && left != "any" && right != "any":
+		return "", false
+	}
+
+	switch operator {
+	case "<", ">", "<=", ">=":
+		return "bool", true
+	}
+	return "", true
+}
+
+func numberInfixType(operator string, left, right string) string {
+	switch operator {
+	case "<", ">", "<=", ">=":
+		return "bool"
+	case "&", "|", "^", "<<", ">>":
+		return "int"
+	}
+
+	if left == "decimal" || right == "decimal" {
+		return "decimal"
+	}
+
+	if left == "float" || right == "float" {
+		return "float"
+	}
+
+	if left == "int" && right == "int" && operator != "/" {
+		return "int"
+	}
+	return "number"
+}
+
+// parameterIdentifier get identifier of parameter, parameters with default
+// value are infix expressions.
+func parameterIdentifier(param ast.Expression) *ast.Identifier {
+	switch param := param.(type) {
+	case *ast.Identifier:
+		return param
+	case *ast.InfixExpression:
+		ident, _ := param.Left.(*ast.Identifier)
+		return ident
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package semantic
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/lexer"
+	"github.com/gravataLonga/ninja/parser"
+	"strings"
+	"testing"
+)
+
+func TestTypeChecker(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedErrors []string
+	}{
+		{
+			`var a: int = 1; var b: float = 1.0; var c: string = "a"; var d: bool = true; var e: array = []; var f: hash = {};`,
+			[]string{},
+		},
+		{
+			`var a: number = 1; var b: number = 1.5; var c: any = "a"; var d: function = function () {};`,
+			[]string{},
+		},
+		{
+			`var a: int = "hello";`,
+			[]string{"type mismatch: cannot assign string to a of type int IDENT at [Line: 1, Offset: 6]"},
+		},
+		{
+			`var a: int = 1; a = 2.5;`,
+			[]string{"type mismatch: cannot assign float to a of type int IDENT at [Line: 1, Offset: 18]"},
+		},
+		{
+			`var a = 1; a = "dynamic";`,
+			[]string{},
+		},
+		{
+			`var a: int = 10 / 2;`,
+			[]string{},
+		},
+		{
+			`const a = "hello"; var b: int = a;`,
+			[]string{"type mismatch: cannot assign string to b of type int IDENT at [Line: 1, Offset: 25]"},
+		},
+		{
+			`var a: bool = 1 < 2 && true;`,
+			[]string{},
+		},
+		{
+			`var a: int = 1 + 2 * 3; var b: float = 1 + 2.5; var c: string = "a" + "b";`,
+			[]string{},
+		},
+		{
+			`1 + "a";`,
+			[]string{"type mismatch: int + string + at [Line: 1, Offset: 3]"},
+		},
+		{
+			`function add(a: int, b: int): int { return a + b; } add(1, "2");`,
+			[]string{`type mismatch: argument #2 (b) expected to be int, got string ( at [Line: 1, Offset: 56]`},
+		},
+		{
+			`function add(a: int, b: int): int { return a + b; } var r: string = add(1, 2);`,
+			[]string{"type mismatch: cannot assign int to r of type string IDENT at [Line: 1, Offset: 58]"},
+		},
+		{
+			`function name(): string { return 1; }`,
+			[]string{"type mismatch: function expected to return string, got int RETURN at [Line: 1, Offset: 33]"},
+		},
+		{
+			`function name(a: string) { return a + 1; }`,
+			[]string{"type mismatch: string + int + at [Line: 1, Offset: 37]"},
+		},
+		{
+			`const add = function (a: int, b: int = 2): int { return a + b; }; add("1");`,
+			[]string{`type mismatch: argument #1 (a) expected to be int, got string ( at [Line: 1, Offset: 70]`},
+		},
+		{
+			`function (a: int = "1") {}`,
+			[]string{"type mismatch: cannot assign string to a of type int IDENT at [Line: 1, Offset: 12]"},
+		},
+		{
+			`var a: integer = 1;`,
+			[]string{"unknown type integer IDENT at [Line: 1, Offset: 15]"},
+		},
+		{
+			`enum Color { case RED; case BLUE; } var c: Color = Color::RED; function paint(c: Color) {} paint(Color::BLUE);`,
+			[]string{},
+		},
+		{
+			`enum Color { case RED; } var c: int = Color::RED;`,
+			[]string{"type mismatch: cannot assign Color to c of type int IDENT at [Line: 1, Offset: 31]"},
+		},
+		{
+			`var a: int = unknown();`,
+			[]string{},
+		},
+		{
+			`var a: decimal = 1.5d * 2 + 0.5; var b: number = 1.5d;`,
+			[]string{},
+		},
+		{
+			`var a: float = 1.5d + 1.0;`,
+			[]string{"type mismatch: cannot assign decimal to a of type float IDENT at [Line: 1, Offset: 6]"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestTypeChecker[%d]", i), func(t *testing.T) {
+			l := lexer.New(strings.NewReader(tt.input))
+			p := parser.New(l)
+			program := p.ParseProgram()
+			if len(p.Errors()) > 0 {
+				t.Fatalf("parser errors: %v", p.Errors())
+			}
+
+			s := New(program)
+			s.Analysis()
+
+			if len(s.Errors()) != len(tt.expectedErrors) {
+				t.Fatalf("expected %d errors. Got: %d (%v)", len(tt.expectedErrors), len(s.Errors()), s.Errors())
+			}
+
+			for i, err := range tt.expectedErrors {
+				if s.Errors()[i] != err {
+					t.Errorf("expected error %q. Got: %q", err, s.Errors()[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("fromCodepoint", object.NewBuiltin(FromCodepoint))
+}
+
+// FromCodepoint create a string from unicode code points, e.g.: fromCodepoint(110, 105) is "ni"
+func FromCodepoint(args ...object.Object) object.Object {
+	err := object.Check(
+		"fromCodepoint", args,
+		object.MinimumArgs(1),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	out := strings.Builder{}
+	for i, arg := range args {
+		codepoint, ok := arg.(*object.Integer)
+		if !ok {
+			return object.NewErrorFormat("TypeError: fromCodepoint() expected argument #%d to be `%s` got `%s`", i+1, object.INTEGER_OBJ, arg.Type())
+		}
+
+		r := rune(codepoint.Value)
+		if int64(r) != codepoint.Value || !utf8.ValidRune(r) {
+			return object.NewErrorFormat("ValueError: fromCodepoint() argument #%d %d isn't a valid code point", i+1, codepoint.Value)
+		}
+		out.WriteRune(r)
+	}
+	return &object.String{Value: out.String()}
+}
@@ -0,0 +1,55 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("decimal", object.NewBuiltin(Decimal))
+}
+
+// Decimal create an exact decimal from integer, float, string or decimal, optional
+// scale and rounding mode round it, e.g.: decimal("12.345", 2, "halfEven")
+func Decimal(args ...object.Object) object.Object {
+	err := object.Check(
+		"decimal", args,
+		object.RangeOfArgs(1, 3),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	for i, t := range []object.ObjectType{object.INTEGER_OBJ, object.STRING_OBJ} {
+		if i+1 < len(args) && args[i+1].Type() != t {
+			return object.NewErrorFormat("TypeError: decimal() expected argument #%d to be `%s` got `%s`", i+2, t, args[i+1].Type())
+		}
+	}
+
+	var value *object.Decimal
+	switch arg := args[0].(type) {
+	case *object.String:
+		value, err = object.ParseDecimal(arg.Value)
+		if err != nil {
+			return object.NewErrorFormat("ValueError: decimal() %s", err)
+		}
+	case *object.Integer, *object.BigInt, *object.Float, *object.Decimal:
+		d, ok := object.ToDecimal(arg)
+		if !ok {
+			return object.NewErrorFormat("ValueError: decimal() could not convert %s to decimal", arg.Inspect())
+		}
+		value = d
+	default:
+		return object.NewErrorFormat("TypeError: decimal() expected argument #1 to be `%s` got `%s`", "number or string", arg.Type())
+	}
+
+	if len(args) == 1 {
+		return value
+	}
+
+	scale, mode, err := object.RoundingArguments("decimal", args[1:])
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return value.Round(scale, mode)
+}
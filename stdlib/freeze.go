@@ -0,0 +1,23 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("freeze", object.NewBuiltin(Freeze))
+}
+
+// Freeze make array or hash deeply read-only and return same object
+func Freeze(args ...object.Object) object.Object {
+	err := object.Check(
+		"freeze", args,
+		object.ExactArgs(1),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	return object.Freeze(args[0])
+}
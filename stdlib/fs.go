@@ -0,0 +1,420 @@
+package stdlib
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func init() {
+	module := object.NewModule("fs")
+	module.Export("read", object.NewBuiltin(FsRead))
+	module.Export("write", object.NewBuiltin(FsWrite))
+	module.Export("append", object.NewBuiltin(FsAppend))
+	module.Export("exists", object.NewBuiltin(FsExists))
+	module.Export("stat", object.NewBuiltin(FsStat))
+	module.Export("remove", object.NewBuiltin(FsRemove))
+	module.Export("rename", object.NewBuiltin(FsRename))
+	module.Export("mkdir", object.NewBuiltin(FsMkdir))
+	module.Export("listDir", object.NewBuiltin(FsListDir))
+	module.Export("glob", object.NewBuiltin(FsGlob))
+	module.Export("walk", object.NewBuiltin(FsWalk))
+	module.Export("tempDir", object.NewBuiltin(FsTempDir))
+	object.GlobalEnvironment.Set("fs", module)
+}
+
+// fsPath check if path is allowed by object.FileSystemRoot
+func fsPath(name string, path string) (string, *object.Error) {
+	if object.FileSystemRoot == "" {
+		return path, nil
+	}
+
+	if !insideRoot(path) {
+		return "", object.NewErrorFormat("PermissionError: %s() path %s is outside of %s", name, path, object.FileSystemRoot)
+	}
+	return path, nil
+}
+
+// SetFileSystemRoot restrict fs module to root directory. Root is resolved
+// once to an absolute path without symbolic links, so changing working
+// directory afterwards doesn't move it. Empty root remove restriction.
+func SetFileSystemRoot(root string) error {
+	if root == "" {
+		object.FileSystemRoot = ""
+		return nil
+	}
+
+	resolved, err := resolvePath(root)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", root)
+	}
+
+	object.FileSystemRoot = resolved
+	return nil
+}
+
+// insideRoot tell if path, after symbolic links are followed, is inside of
+// object.FileSystemRoot
+func insideRoot(path string) bool {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(object.FileSystemRoot, resolved)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolvePath get absolute path where path really point to, following symbolic
+// links one element at a time, so ".." after a link go to parent of link
+// target as operating system does. Elements which don't exist yet are kept
+// as they are.
+func resolvePath(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		path = cwd + string(filepath.Separator) + path
+	}
+
+	resolved := filepath.VolumeName(path) + string(filepath.Separator)
+	for _, element := range strings.Split(path[len(filepath.VolumeName(path)):], string(filepath.Separator)) {
+		switch element {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, element)
+		if _, err := os.Lstat(next); err != nil {
+			if !os.IsNotExist(err) {
+				return "", err
+			}
+			resolved = next
+			continue
+		}
+
+		real, err := filepath.EvalSymlinks(next)
+		if err != nil {
+			return "", err
+		}
+		resolved = real
+	}
+	return resolved, nil
+}
+
+// fsArguments check arguments and get paths allowed by sandbox, first paths
+// arguments must be strings
+func fsArguments(name string, args []object.Object, paths int, checks ...object.CheckFunc) ([]string, *object.Error) {
+	err := object.Check(name, args, checks...)
+	if err != nil {
+		return nil, object.NewError(err.Error())
+	}
+
+	result := make([]string, paths)
+	for i := 0; i < paths; i++ {
+		str, ok := args[i].(*object.String)
+		if !ok {
+			return nil, object.NewErrorFormat("TypeError: %s() expected argument #%d to be `%s` got `%s`", name, i+1, object.STRING_OBJ, args[i].Type())
+		}
+
+		path, pathErr := fsPath(name, str.Value)
+		if pathErr != nil {
+			return nil, pathErr
+		}
+		result[i] = path
+	}
+	return result, nil
+}
+
+func ioError(name string, err error) *object.Error {
+	return object.NewErrorFormat("IOError: %s() %s", name, err)
+}
+
+// FsRead read whole file as string
+func FsRead(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.read", args, 1, object.ExactArgs(1))
+	if err != nil {
+		return err
+	}
+
+	content, readErr := os.ReadFile(paths[0])
+	if readErr != nil {
+		return ioError("fs.read", readErr)
+	}
+	return &object.String{Value: string(content)}
+}
+
+// FsWrite create or truncate file with content
+func FsWrite(args ...object.Object) object.Object {
+	return fsWrite("fs.write", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, args...)
+}
+
+// FsAppend add content at end of file, file is created when it doesn't exist
+func FsAppend(args ...object.Object) object.Object {
+	return fsWrite("fs.append", os.O_WRONLY|os.O_CREATE|os.O_APPEND, args...)
+}
+
+func fsWrite(name string, flag int, args ...object.Object) object.Object {
+	paths, err := fsArguments(name, args, 1, object.ExactArgs(2), object.WithTypes(object.STRING_OBJ, object.STRING_OBJ))
+	if err != nil {
+		return err
+	}
+
+	file, openErr := os.OpenFile(paths[0], flag, 0644)
+	if openErr != nil {
+		return ioError(name, openErr)
+	}
+
+	_, writeErr := file.WriteString(args[1].(*object.String).Value)
+	if closeErr := file.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+
+	if writeErr != nil {
+		return ioError(name, writeErr)
+	}
+	return object.NULL
+}
+
+// FsExists tell if path exists
+func FsExists(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.exists", args, 1, object.ExactArgs(1))
+	if err != nil {
+		return err
+	}
+
+	_, statErr := os.Stat(paths[0])
+	if statErr == nil {
+		return object.TRUE
+	}
+	return object.FALSE
+}
+
+// FsStat get information about path: name, size, isDir, mode and modTime (unix time)
+func FsStat(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.stat", args, 1, object.ExactArgs(1))
+	if err != nil {
+		return err
+	}
+
+	info, statErr := os.Stat(paths[0])
+	if statErr != nil {
+		return ioError("fs.stat", statErr)
+	}
+
+	return newHash(
+		"name", &object.String{Value: info.Name()},
+		"size", &object.Integer{Value: info.Size()},
+		"isDir", nativeBool(info.IsDir()),
+		"mode", &object.String{Value: info.Mode().String()},
+		"modTime", &object.Integer{Value: info.ModTime().Unix()},
+	)
+}
+
+// FsRemove remove file or empty directory, with recursive as true it remove
+// directory and everything inside
+func FsRemove(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.remove", args, 1, object.RangeOfArgs(1, 2), object.WithTypes(object.STRING_OBJ, object.BOOLEAN_OBJ))
+	if err != nil {
+		return err
+	}
+
+	remove := os.Remove
+	if len(args) == 2 && object.IsTruthy(args[1]) {
+		if _, statErr := os.Stat(paths[0]); statErr != nil {
+			return ioError("fs.remove", statErr)
+		}
+		remove = os.RemoveAll
+	}
+
+	if removeErr := remove(paths[0]); removeErr != nil {
+		return ioError("fs.remove", removeErr)
+	}
+	return object.NULL
+}
+
+// FsRename move path into a new one
+func FsRename(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.rename", args, 2, object.ExactArgs(2))
+	if err != nil {
+		return err
+	}
+
+	if renameErr := os.Rename(paths[0], paths[1]); renameErr != nil {
+		return ioError("fs.rename", renameErr)
+	}
+	return object.NULL
+}
+
+// FsMkdir create directory and any missing parent
+func FsMkdir(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.mkdir", args, 1, object.ExactArgs(1))
+	if err != nil {
+		return err
+	}
+
+	if mkdirErr := os.MkdirAll(paths[0], 0755); mkdirErr != nil {
+		return ioError("fs.mkdir", mkdirErr)
+	}
+	return object.NULL
+}
+
+// FsListDir get names of entries of directory sorted by name
+func FsListDir(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.listDir", args, 1, object.ExactArgs(1))
+	if err != nil {
+		return err
+	}
+
+	entries, readErr := os.ReadDir(paths[0])
+	if readErr != nil {
+		return ioError("fs.listDir", readErr)
+	}
+
+	elements := make([]object.Object, len(entries))
+	for i, entry := range entries {
+		elements[i] = &object.String{Value: entry.Name()}
+	}
+	return &object.Array{Elements: elements}
+}
+
+// FsGlob get paths which match pattern, e.g.: fs.glob("data/*.json")
+func FsGlob(args ...object.Object) object.Object {
+	err := object.Check(
+		"fs.glob", args,
+		object.ExactArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	matches, globErr := filepath.Glob(args[0].(*object.String).Value)
+	if globErr != nil {
+		return object.NewErrorFormat("ValueError: fs.glob() %s", globErr)
+	}
+
+	sort.Strings(matches)
+	elements := []object.Object{}
+	for _, match := range matches {
+		if object.FileSystemRoot != "" && !insideRoot(match) {
+			continue
+		}
+		elements = append(elements, &object.String{Value: match})
+	}
+	return &object.Array{Elements: elements}
+}
+
+// errWalkStopped stop fs.walk() when function give an error
+var errWalkStopped = errors.New("walk stopped")
+
+// FsWalk get every path inside of directory, recursively. When a function is
+// given it is called with each path instead.
+func FsWalk(args ...object.Object) object.Object {
+	paths, err := fsArguments("fs.walk", args, 1, object.RangeOfArgs(1, 2))
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 2 && args[1].Type() != object.FUNCTION_OBJ && args[1].Type() != object.BUILTIN_OBJ {
+		return object.NewErrorFormat("TypeError: fs.walk() expected argument #2 to be `%s` got `%s`", object.FUNCTION_OBJ, args[1].Type())
+	}
+
+	elements := []object.Object{}
+	var failure object.Object
+	walkErr := filepath.WalkDir(paths[0], func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == paths[0] {
+			return nil
+		}
+
+		value := &object.String{Value: path}
+		if len(args) == 1 {
+			elements = append(elements, value)
+			return nil
+		}
+
+		result := object.ApplyFunction(args[1], []object.Object{value})
+		if object.IsError(result) {
+			failure = result
+			return errWalkStopped
+		}
+		return nil
+	})
+
+	if walkErr == errWalkStopped {
+		return failure
+	}
+
+	if walkErr != nil {
+		return ioError("fs.walk", walkErr)
+	}
+
+	if len(args) == 2 {
+		return object.NULL
+	}
+	return &object.Array{Elements: elements}
+}
+
+// FsTempDir create a new temporary directory and give it is path
+func FsTempDir(args ...object.Object) object.Object {
+	err := object.Check(
+		"fs.tempDir", args,
+		object.RangeOfArgs(0, 1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	pattern := "ninja"
+	if len(args) == 1 {
+		pattern = args[0].(*object.String).Value
+	}
+
+	dir, mkdirErr := os.MkdirTemp(object.FileSystemRoot, pattern)
+	if mkdirErr != nil {
+		return ioError("fs.tempDir", mkdirErr)
+	}
+	return &object.String{Value: dir}
+}
+
+// newHash create hash from pairs of string keys and values
+func newHash(pairs ...interface{}) *object.Hash {
+	hash := object.NewHash()
+	for i := 0; i < len(pairs); i += 2 {
+		key := &object.String{Value: pairs[i].(string)}
+		hash.Set(key.HashKey(), object.HashPair{Key: key, Value: pairs[i+1].(object.Object)})
+	}
+	return hash
+}
+
+func nativeBool(value bool) *object.Boolean {
+	if value {
+		return object.TRUE
+	}
+	return object.FALSE
+}
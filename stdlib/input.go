@@ -0,0 +1,129 @@
+package stdlib
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"io"
+	"strings"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("input", object.NewBuiltin(Input))
+	object.GlobalEnvironment.Set("readLine", object.NewBuiltin(ReadLine))
+	object.GlobalEnvironment.Set("readAll", object.NewBuiltin(ReadAll))
+	object.GlobalEnvironment.Set("lines", object.NewBuiltin(Lines))
+}
+
+// stdin keep a single buffered reader over standard input, so builtins don't
+// lose data buffered by each other. It is recreated when standard input change.
+var stdin struct {
+	source io.Reader
+	reader *bufio.Reader
+}
+
+func standardInput() *bufio.Reader {
+	if stdin.reader == nil || stdin.source != object.StandardInput {
+		stdin.source = object.StandardInput
+		stdin.reader = bufio.NewReader(object.StandardInput)
+	}
+	return stdin.reader
+}
+
+// readLine read next line without line break, it give nil at end of input
+func readLine(name string) object.Object {
+	line, err := standardInput().ReadString('\n')
+	if err != nil && err != io.EOF {
+		return object.NewErrorFormat("IOError: %s() %s", name, err)
+	}
+
+	if err == io.EOF && line == "" {
+		return nil
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return &object.String{Value: line}
+}
+
+// Input print prompt and read a line from standard input, null at end of input
+func Input(args ...object.Object) object.Object {
+	err := object.Check(
+		"input", args,
+		object.RangeOfArgs(0, 1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	if len(args) == 1 {
+		if _, err := fmt.Fprint(object.StandardOutput, args[0].(*object.String).Value); err != nil {
+			return object.NewError("Unable to print to standard output")
+		}
+	}
+
+	line := readLine("input")
+	if line == nil {
+		return object.NULL
+	}
+	return line
+}
+
+// ReadLine read a line from standard input, null at end of input
+func ReadLine(args ...object.Object) object.Object {
+	err := object.Check(
+		"readLine", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	line := readLine("readLine")
+	if line == nil {
+		return object.NULL
+	}
+	return line
+}
+
+// ReadAll read everything left on standard input, null at end of input
+func ReadAll(args ...object.Object) object.Object {
+	err := object.Check(
+		"readAll", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	content, err := io.ReadAll(standardInput())
+	if err != nil {
+		return object.NewErrorFormat("IOError: readAll() %s", err)
+	}
+
+	if len(content) == 0 {
+		return object.NULL
+	}
+	return &object.String{Value: string(content)}
+}
+
+// Lines give an iterator over lines of standard input, each line is only read
+// when next() is called.
+func Lines(args ...object.Object) object.Object {
+	err := object.Check(
+		"lines", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	return object.NewIterator(func() object.Object {
+		return readLine("lines")
+	})
+}
@@ -0,0 +1,187 @@
+package stdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gravataLonga/ninja/object"
+	"io"
+	"os"
+	"strings"
+)
+
+func init() {
+	module := object.NewModule("json")
+	module.Export("encode", object.NewBuiltin(JSONEncode))
+	module.Export("decode", object.NewBuiltin(JSONDecode))
+	module.Export("decoder", object.NewBuiltin(JSONDecoder))
+	object.GlobalEnvironment.Set("json", module)
+}
+
+// JSONEncode render value as JSON, e.g.: json.encode({"a": [1]}, {"indent": 2})
+func JSONEncode(args ...object.Object) object.Object {
+	err := object.Check(
+		"json.encode", args,
+		object.RangeOfArgs(1, 2),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	indent := ""
+	if len(args) == 2 {
+		options, optionsErr := moduleOptions("json.encode", args[1], "indent")
+		if optionsErr != nil {
+			return optionsErr
+		}
+
+		switch value := options["indent"].(type) {
+		case nil:
+		case *object.Integer:
+			if value.Value < 0 {
+				return object.NewErrorFormat("ValueError: json.encode() indent must not be negative got %d", value.Value)
+			}
+			indent = strings.Repeat(" ", int(value.Value))
+		case *object.String:
+			indent = value.Value
+		default:
+			return object.NewErrorFormat("TypeError: json.encode() option indent expected to be `INTEGER` or `STRING` got `%s`", value.Type())
+		}
+	}
+
+	encoded, err := object.EncodeJSON("json.encode", args[0])
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	if indent != "" {
+		out := bytes.Buffer{}
+		json.Indent(&out, []byte(encoded), "", indent)
+		encoded = out.String()
+	}
+	return &object.String{Value: encoded}
+}
+
+// JSONDecode parse JSON string into ninja values
+func JSONDecode(args ...object.Object) object.Object {
+	err := object.Check(
+		"json.decode", args,
+		object.ExactArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	value, err := object.DecodeJSON("json.decode", args[0].(*object.String).Value)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return value
+}
+
+// JSONDecoder give an iterator which decode one value at a time, from string,
+// from a file with {"file": "data.json"} or standard input when both are
+// omitted. With {"elements": true} it iterate over elements of an array.
+func JSONDecoder(args ...object.Object) object.Object {
+	err := object.Check(
+		"json.decoder", args,
+		object.RangeOfArgs(0, 2),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	var source io.Reader = standardInput()
+	text := false
+	if len(args) > 0 {
+		if str, ok := args[0].(*object.String); ok {
+			source = strings.NewReader(str.Value)
+			text = true
+			args = args[1:]
+		}
+	}
+
+	elements := false
+	var file *os.File
+	if len(args) > 0 {
+		if len(args) > 1 {
+			return object.NewErrorFormat("TypeError: json.decoder() expected argument #1 to be `STRING` got `%s`", args[0].Type())
+		}
+
+		options, optionsErr := moduleOptions("json.decoder", args[0], "elements", "file")
+		if optionsErr != nil {
+			return optionsErr
+		}
+
+		if value, ok := options["elements"]; ok {
+			elements = object.IsTruthy(value)
+		}
+
+		if value, ok := options["file"]; ok {
+			path, ok := value.(*object.String)
+			if !ok {
+				return object.NewErrorFormat("TypeError: json.decoder() option file expected to be `%s` got `%s`", object.STRING_OBJ, value.Type())
+			}
+
+			if text {
+				return object.NewErrorFormat("ValueError: json.decoder() can't decode from string and file at same time")
+			}
+
+			filename, pathErr := fsPath("json.decoder", path.Value)
+			if pathErr != nil {
+				return pathErr
+			}
+
+			var openErr error
+			file, openErr = os.Open(filename)
+			if openErr != nil {
+				return ioError("json.decoder", openErr)
+			}
+			source = file
+		}
+	}
+
+	decoder := object.NewJSONDecoder("json.decoder", source, elements)
+	return object.NewIterator(func() object.Object {
+		value, err := decoder.Next()
+		if err != nil {
+			value = object.NewError(err.Error())
+		}
+
+		// file is closed once it is exhausted or it can't be decoded anymore
+		if file != nil && (value == nil || err != nil) {
+			file.Close()
+		}
+		return value
+	})
+}
+
+// moduleOptions get options from a hash, only names are allowed
+func moduleOptions(name string, arg object.Object, names ...string) (map[string]object.Object, *object.Error) {
+	hash, ok := arg.(*object.Hash)
+	if !ok {
+		return nil, object.NewErrorFormat("TypeError: %s() expected options to be `%s` got `%s`", name, object.HASH_OBJ, arg.Type())
+	}
+
+	options := map[string]object.Object{}
+	for _, pair := range hash.OrderedPairs() {
+		key, ok := pair.Key.(*object.String)
+		if !ok || !contains(names, key.Value) {
+			return nil, object.NewErrorFormat("ValueError: %s() unknown option %s, expected one of %s", name, pair.Key.Inspect(), strings.Join(names, ", "))
+		}
+		options[key.Value] = pair.Value
+	}
+	return options, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
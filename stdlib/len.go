@@ -2,6 +2,7 @@ package stdlib
 
 import (
 	"github.com/gravataLonga/ninja/object"
+	"unicode/utf8"
 )
 
 func init() {
@@ -13,7 +14,7 @@ func Len(args ...object.Object) object.Object {
 	err := object.Check(
 		"len", args,
 		object.ExactArgs(1),
-		object.OneOfType(object.ARRAY_OBJ, object.STRING_OBJ),
+		object.OneOfType(object.ARRAY_OBJ, object.STRING_OBJ, object.SET_OBJ),
 	)
 
 	if err != nil {
@@ -24,7 +25,9 @@ func Len(args ...object.Object) object.Object {
 	case *object.Array:
 		return &object.Integer{Value: int64(len(arg.Elements))}
 	case *object.String:
-		return &object.Integer{Value: int64(len(arg.Value))}
+		return &object.Integer{Value: int64(utf8.RuneCountInString(arg.Value))}
+	case *object.Set:
+		return &object.Integer{Value: int64(arg.Len())}
 	default:
 		return object.NewErrorFormat("argument to `len` not supported, got %s", args[0].Type())
 	}
@@ -0,0 +1,305 @@
+package stdlib
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gravataLonga/ninja/object"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+func init() {
+	module := object.NewModule("os")
+	module.Export("env", object.NewBuiltin(OsEnv))
+	module.Export("setEnv", object.NewBuiltin(OsSetEnv))
+	module.Export("environ", object.NewBuiltin(OsEnviron))
+	module.Export("cwd", object.NewBuiltin(OsCwd))
+	module.Export("chdir", object.NewBuiltin(OsChdir))
+	module.Export("pid", object.NewBuiltin(OsPid))
+	module.Export("hostname", object.NewBuiltin(OsHostname))
+	module.Export("exec", object.NewBuiltin(OsExec))
+	object.GlobalEnvironment.Set("os", module)
+}
+
+// OsEnv get value of environment variable, when it isn't defined it give
+// default value or null
+func OsEnv(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.env", args,
+		object.RangeOfArgs(1, 2),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	value, ok := os.LookupEnv(args[0].(*object.String).Value)
+	if ok {
+		return &object.String{Value: value}
+	}
+
+	if len(args) == 2 {
+		return args[1]
+	}
+	return object.NULL
+}
+
+// OsSetEnv define environment variable, it is visible to commands run by os.exec
+func OsSetEnv(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.setEnv", args,
+		object.ExactArgs(2),
+		object.WithTypes(object.STRING_OBJ, object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	if setErr := os.Setenv(args[0].(*object.String).Value, args[1].(*object.String).Value); setErr != nil {
+		return object.NewErrorFormat("ValueError: os.setEnv() %s", setErr)
+	}
+	return object.NULL
+}
+
+// OsEnviron get all environment variables as a hash sorted by name
+func OsEnviron(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.environ", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	environ := os.Environ()
+	sort.Strings(environ)
+
+	hash := object.NewHash()
+	for _, variable := range environ {
+		name, value, _ := strings.Cut(variable, "=")
+		key := &object.String{Value: name}
+		hash.Set(key.HashKey(), object.HashPair{Key: key, Value: &object.String{Value: value}})
+	}
+	return hash
+}
+
+// OsCwd get current working directory
+func OsCwd(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.cwd", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	dir, cwdErr := os.Getwd()
+	if cwdErr != nil {
+		return ioError("os.cwd", cwdErr)
+	}
+	return &object.String{Value: dir}
+}
+
+// OsChdir change current working directory
+func OsChdir(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.chdir", args,
+		object.ExactArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	if chdirErr := os.Chdir(args[0].(*object.String).Value); chdirErr != nil {
+		return ioError("os.chdir", chdirErr)
+	}
+	return object.NULL
+}
+
+// OsPid get process id of interpreter
+func OsPid(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.pid", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return &object.Integer{Value: int64(os.Getpid())}
+}
+
+// OsHostname get name of machine
+func OsHostname(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.hostname", args,
+		object.ExactArgs(0),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	hostname, hostnameErr := os.Hostname()
+	if hostnameErr != nil {
+		return ioError("os.hostname", hostnameErr)
+	}
+	return &object.String{Value: hostname}
+}
+
+// execWaitDelay is how long os.exec() wait for output to be closed after
+// command exit or it is killed, output written after it by children left on
+// background isn't captured
+var execWaitDelay = 100 * time.Millisecond
+
+// OsExec run a command and wait until it finish, e.g.: os.exec("ls", ["-l"], {"dir": "/tmp"}).
+// It give a hash with stdout, stderr and code, a command which exit with a code
+// other than 0 isn't an error. Options are:
+//   - stdin: string written to input of command
+//   - env: hash of variables added to environment of command
+//   - dir: directory where command run
+//   - stream: when true output is also written to output of program as it arrive
+//   - timeout: milliseconds to wait before command is killed
+func OsExec(args ...object.Object) object.Object {
+	err := object.Check(
+		"os.exec", args,
+		object.RangeOfArgs(1, 3),
+		object.WithTypes(object.STRING_OBJ, object.ARRAY_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	var arguments []string
+	if len(args) >= 2 {
+		for i, arg := range args[1].(*object.Array).Elements {
+			str, ok := arg.(*object.String)
+			if !ok {
+				return object.NewErrorFormat("TypeError: os.exec() expected argument %d of command to be `%s` got `%s`", i, object.STRING_OBJ, arg.Type())
+			}
+			arguments = append(arguments, str.Value)
+		}
+	}
+
+	options := map[string]object.Object{}
+	if len(args) == 3 {
+		var optionsErr *object.Error
+		options, optionsErr = moduleOptions("os.exec", args[2], "stdin", "env", "dir", "stream", "timeout")
+		if optionsErr != nil {
+			return optionsErr
+		}
+	}
+
+	ctx := context.Background()
+	if timeout, ok := options["timeout"]; ok {
+		milliseconds, ok := timeout.(*object.Integer)
+		if !ok {
+			return object.NewErrorFormat("TypeError: os.exec() option timeout expected to be `%s` got `%s`", object.INTEGER_OBJ, timeout.Type())
+		}
+
+		if milliseconds.Value <= 0 {
+			return object.NewErrorFormat("ValueError: os.exec() timeout must be positive got %d", milliseconds.Value)
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(milliseconds.Value)*time.Millisecond)
+		defer cancel()
+	}
+
+	name := args[0].(*object.String).Value
+	cmd := exec.CommandContext(ctx, name, arguments...)
+	if _, ok := options["timeout"]; ok {
+		// only commands which can time out get their own process group, others
+		// stay on ours so they still receive Ctrl-C
+		killProcessGroup(cmd)
+	}
+	// don't wait forever for output to be closed by children of command
+	cmd.WaitDelay = execWaitDelay
+	if optionErr := execOptions(cmd, options); optionErr != nil {
+		return optionErr
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if stream, ok := options["stream"]; ok && stream != object.TRUE && stream != object.FALSE {
+		return object.NewErrorFormat("TypeError: os.exec() option stream expected to be `%s` got `%s`", object.BOOLEAN_OBJ, stream.Type())
+	}
+
+	if options["stream"] == object.TRUE {
+		cmd.Stdout = io.MultiWriter(stdout, object.StandardOutput)
+		cmd.Stderr = io.MultiWriter(stderr, object.StandardError)
+	}
+
+	code := 0
+	runErr := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return object.NewErrorFormat("TimeoutError: os.exec() %s didn't finish after %dms", name, options["timeout"].(*object.Integer).Value)
+	}
+
+	var exitErr *exec.ExitError
+	if errors.Is(runErr, exec.ErrWaitDelay) {
+		// command finished, only children started on background still hold output
+		code = cmd.ProcessState.ExitCode()
+	} else if errors.As(runErr, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if runErr != nil {
+		return ioError("os.exec", runErr)
+	}
+
+	return newHash(
+		"stdout", &object.String{Value: stdout.String()},
+		"stderr", &object.String{Value: stderr.String()},
+		"code", &object.Integer{Value: int64(code)},
+	)
+}
+
+// execOptions apply stdin, env and dir options to command
+func execOptions(cmd *exec.Cmd, options map[string]object.Object) *object.Error {
+	if stdin, ok := options["stdin"]; ok {
+		str, ok := stdin.(*object.String)
+		if !ok {
+			return object.NewErrorFormat("TypeError: os.exec() option stdin expected to be `%s` got `%s`", object.STRING_OBJ, stdin.Type())
+		}
+		cmd.Stdin = strings.NewReader(str.Value)
+	}
+
+	if dir, ok := options["dir"]; ok {
+		str, ok := dir.(*object.String)
+		if !ok {
+			return object.NewErrorFormat("TypeError: os.exec() option dir expected to be `%s` got `%s`", object.STRING_OBJ, dir.Type())
+		}
+		cmd.Dir = str.Value
+	}
+
+	if env, ok := options["env"]; ok {
+		hash, ok := env.(*object.Hash)
+		if !ok {
+			return object.NewErrorFormat("TypeError: os.exec() option env expected to be `%s` got `%s`", object.HASH_OBJ, env.Type())
+		}
+
+		cmd.Env = os.Environ()
+		for _, pair := range hash.OrderedPairs() {
+			key, keyOk := pair.Key.(*object.String)
+			value, valueOk := pair.Value.(*object.String)
+			if !keyOk || !valueOk {
+				return object.NewErrorFormat("TypeError: os.exec() option env expected names and values to be `%s` got %s: %s", object.STRING_OBJ, pair.Key.Type(), pair.Value.Type())
+			}
+			cmd.Env = append(cmd.Env, key.Value+"="+value.Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+
+package stdlib
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// killProcessGroup run command on it is own process group and make
+// cancellation kill whole group, so children started by command don't keep
+// running (and holding output open) after it is killed
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
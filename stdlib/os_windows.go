@@ -0,0 +1,7 @@
+package stdlib
+
+import "os/exec"
+
+// killProcessGroup on windows only command is killed, children are left to
+// cmd.WaitDelay
+func killProcessGroup(cmd *exec.Cmd) {}
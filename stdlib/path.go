@@ -0,0 +1,132 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+	"path/filepath"
+)
+
+func init() {
+	module := object.NewModule("path")
+	module.Export("join", object.NewBuiltin(PathJoin))
+	module.Export("dir", object.NewBuiltin(pathFunction("path.dir", filepath.Dir)))
+	module.Export("base", object.NewBuiltin(pathFunction("path.base", filepath.Base)))
+	module.Export("ext", object.NewBuiltin(pathFunction("path.ext", filepath.Ext)))
+	module.Export("clean", object.NewBuiltin(pathFunction("path.clean", filepath.Clean)))
+	module.Export("abs", object.NewBuiltin(PathAbs))
+	module.Export("rel", object.NewBuiltin(PathRel))
+	module.Export("split", object.NewBuiltin(PathSplit))
+	module.Export("match", object.NewBuiltin(PathMatch))
+	object.GlobalEnvironment.Set("path", module)
+}
+
+// pathFunction create builtin which receive a single path and give a string
+func pathFunction(name string, fn func(path string) string) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		err := object.Check(
+			name, args,
+			object.ExactArgs(1),
+			object.WithTypes(object.STRING_OBJ),
+		)
+
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return &object.String{Value: fn(args[0].(*object.String).Value)}
+	}
+}
+
+// PathJoin join any number of parts with separator of operating system, e.g.:
+// path.join("a", "b", "c.txt") is "a/b/c.txt"
+func PathJoin(args ...object.Object) object.Object {
+	err := object.Check(
+		"path.join", args,
+		object.MinimumArgs(1),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		str, ok := arg.(*object.String)
+		if !ok {
+			return object.NewErrorFormat("TypeError: path.join() expected argument #%d to be `%s` got `%s`", i+1, object.STRING_OBJ, arg.Type())
+		}
+		parts[i] = str.Value
+	}
+	return &object.String{Value: filepath.Join(parts...)}
+}
+
+// PathAbs give absolute path, relative paths are resolved from current working directory
+func PathAbs(args ...object.Object) object.Object {
+	err := object.Check(
+		"path.abs", args,
+		object.ExactArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	abs, absErr := filepath.Abs(args[0].(*object.String).Value)
+	if absErr != nil {
+		return object.NewErrorFormat("IOError: path.abs() %s", absErr)
+	}
+	return &object.String{Value: abs}
+}
+
+// PathRel give target path relative to base, e.g.: path.rel("/a", "/a/b/c") is "b/c"
+func PathRel(args ...object.Object) object.Object {
+	err := object.Check(
+		"path.rel", args,
+		object.ExactArgs(2),
+		object.WithTypes(object.STRING_OBJ, object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	rel, relErr := filepath.Rel(args[0].(*object.String).Value, args[1].(*object.String).Value)
+	if relErr != nil {
+		return object.NewErrorFormat("ValueError: path.rel() %s", relErr)
+	}
+	return &object.String{Value: rel}
+}
+
+// PathSplit split path into directory and file name, e.g.: ["a/b/", "c.txt"]
+func PathSplit(args ...object.Object) object.Object {
+	err := object.Check(
+		"path.split", args,
+		object.ExactArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	dir, file := filepath.Split(args[0].(*object.String).Value)
+	return &object.Array{Elements: []object.Object{&object.String{Value: dir}, &object.String{Value: file}}}
+}
+
+// PathMatch tell if name match shell pattern, e.g.: path.match("*.txt", "a.txt")
+func PathMatch(args ...object.Object) object.Object {
+	err := object.Check(
+		"path.match", args,
+		object.ExactArgs(2),
+		object.WithTypes(object.STRING_OBJ, object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	matched, matchErr := filepath.Match(args[0].(*object.String).Value, args[1].(*object.String).Value)
+	if matchErr != nil {
+		return object.NewErrorFormat("ValueError: path.match() %s", matchErr)
+	}
+	return nativeBool(matched)
+}
@@ -0,0 +1,80 @@
+package stdlib
+
+import (
+	"fmt"
+	"github.com/gravataLonga/ninja/object"
+	"io"
+	"strings"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("sprintf", object.NewBuiltin(Sprintf))
+	object.GlobalEnvironment.Set("printf", object.NewBuiltin(Printf))
+	object.GlobalEnvironment.Set("print", object.NewBuiltin(Print))
+	object.GlobalEnvironment.Set("eprint", object.NewBuiltin(Eprint))
+}
+
+// Sprintf format arguments, e.g.: sprintf("%05.2f %v", 3.14159, [1]) is "03.14 [1]"
+func Sprintf(args ...object.Object) object.Object {
+	value, err := sprintf("sprintf", args...)
+	if err != nil {
+		return err
+	}
+	return &object.String{Value: value}
+}
+
+// Printf format arguments and print them to standard output, without new line
+func Printf(args ...object.Object) object.Object {
+	value, err := sprintf("printf", args...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(object.StandardOutput, value); err != nil {
+		return object.NewError("Unable to print to standard output")
+	}
+	return nil
+}
+
+// Print write arguments to standard output, without new line
+func Print(args ...object.Object) object.Object {
+	return printTo(object.StandardOutput, "standard output", args...)
+}
+
+// Eprint write arguments to standard error, without new line
+func Eprint(args ...object.Object) object.Object {
+	return printTo(object.StandardError, "standard error", args...)
+}
+
+func printTo(writer io.Writer, name string, args ...object.Object) object.Object {
+	out := strings.Builder{}
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		out.WriteString(arg.Inspect())
+	}
+
+	if _, err := fmt.Fprint(writer, out.String()); err != nil {
+		return object.NewErrorFormat("Unable to print to %s", name)
+	}
+	return nil
+}
+
+func sprintf(name string, args ...object.Object) (string, *object.Error) {
+	err := object.Check(
+		name, args,
+		object.MinimumArgs(1),
+		object.WithTypes(object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return "", object.NewError(err.Error())
+	}
+
+	value, err := object.Sprintf(name, args[0].(*object.String).Value, args[1:])
+	if err != nil {
+		return "", object.NewError(err.Error())
+	}
+	return value, nil
+}
@@ -3,24 +3,35 @@ package stdlib
 import (
 	"fmt"
 	"github.com/gravataLonga/ninja/object"
+	"io"
 )
 
 func init() {
 	object.GlobalEnvironment.Set("puts", object.NewBuiltin(Puts))
+	object.GlobalEnvironment.Set("eputs", object.NewBuiltin(Eputs))
 }
 
 // Puts print stuff to standard output
 func Puts(args ...object.Object) object.Object {
+	return putsTo(object.StandardOutput, "standard output", args...)
+}
+
+// Eputs print stuff to standard error
+func Eputs(args ...object.Object) object.Object {
+	return putsTo(object.StandardError, "standard error", args...)
+}
+
+// putsTo write each argument on it is own line
+func putsTo(writer io.Writer, name string, args ...object.Object) object.Object {
 	for _, arg := range args {
-		if arg == nil {
-			_, err := fmt.Fprintln(object.StandardOutput, "Argument is nil")
-			if err != nil {
-				return object.NewError("Unable to put to standard output")
+		value := "Argument is nil"
+		if arg != nil {
+			value = arg.Inspect()
+		}
 
-			}
-			continue
+		if _, err := fmt.Fprintln(writer, value); err != nil {
+			return object.NewErrorFormat("Unable to put to %s", name)
 		}
-		fmt.Println(arg.Inspect())
 	}
 
 	return nil
@@ -0,0 +1,33 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("regex", object.NewBuiltin(Regex))
+}
+
+// Regex compile a regular expression, e.g.: regex("[a-z]+", "i")
+func Regex(args ...object.Object) object.Object {
+	err := object.Check(
+		"regex", args,
+		object.RangeOfArgs(1, 2),
+		object.WithTypes(object.STRING_OBJ, object.STRING_OBJ),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	flags := ""
+	if len(args) == 2 {
+		flags = args[1].(*object.String).Value
+	}
+
+	regex, err := object.NewRegex(args[0].(*object.String).Value, flags)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return regex
+}
@@ -0,0 +1,39 @@
+package stdlib
+
+import (
+	"github.com/gravataLonga/ninja/object"
+)
+
+func init() {
+	object.GlobalEnvironment.Set("set", object.NewBuiltin(Set))
+}
+
+// Set create a set from elements of an array or another set, e.g.: set([1, 2, 2]) is {1, 2}
+func Set(args ...object.Object) object.Object {
+	err := object.Check(
+		"set", args,
+		object.RangeOfArgs(0, 1),
+	)
+
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+
+	var elements []object.Object
+	if len(args) == 1 {
+		switch arg := args[0].(type) {
+		case *object.Array:
+			elements = arg.Elements
+		case *object.Set:
+			elements = arg.Values()
+		default:
+			return object.NewErrorFormat("TypeError: set() expected argument #1 to be `%s` got `%s`", "ARRAY or SET", arg.Type())
+		}
+	}
+
+	set, err := object.NewSet(elements...)
+	if err != nil {
+		return object.NewErrorFormat("TypeError: set() %s", err)
+	}
+	return set
+}
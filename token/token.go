@@ -27,6 +27,7 @@ func (t TokenType) String() string {
 		"IDENT",
 		"INT",
 		"FLOAT",
+		"DECIMAL",
 		"STRING",
 		"=",
 		"+",
@@ -79,6 +80,9 @@ func (t TokenType) String() string {
 		"BREAK",
 		"ENUM",
 		"CASE",
+		"CONST",
+		"MACRO",
+		"EXPORT",
 	}
 
 	if len(list)-1 < int(t) {
@@ -93,16 +97,20 @@ const (
 	DIGIT_TYPE_FLOAT
 	DIGIT_TYPE_SCIENTIFIC_NOTATION
 	DIGIT_TYPE_HEXADECIMAL
+	DIGIT_TYPE_BINARY
+	DIGIT_TYPE_OCTAL
+	DIGIT_TYPE_EXACT_DECIMAL
 )
 
 const (
 	ILLEGAL TokenType = iota //  "ILLEGAL"
 	EOF                      // "EOF"
 
-	IDENT  // "IDENT"
-	INT    // "INT"
-	FLOAT  // "FLOAT"
-	STRING // "STRING"
+	IDENT   // "IDENT"
+	INT     // "INT"
+	FLOAT   // "FLOAT"
+	DECIMAL // "DECIMAL"
+	STRING  // "STRING"
 
 	ASSIGN      // "="
 	PLUS        // "+"
@@ -160,6 +168,9 @@ const (
 	BREAK    // "BREAK"
 	ENUM     // "ENUM"
 	CASE     // "CASE"
+	CONST    // "CONST"
+	MACRO    // "MACRO"
+	EXPORT   // "EXPORT"
 
 	ENDTOKEN // Special token, only for testing purposes
 )
@@ -179,6 +190,9 @@ var keywords = map[string]TokenType{
 	"break":    BREAK,
 	"enum":     ENUM,
 	"case":     CASE,
+	"const":    CONST,
+	"macro":    MACRO,
+	"export":   EXPORT,
 }
 
 // LookupIdentifier it will search from []byte() it's keyword token
@@ -205,8 +219,10 @@ func (d DigitType) TokenType() TokenType {
 		return INT
 	case DIGIT_TYPE_SCIENTIFIC_NOTATION:
 		return FLOAT
-	case DIGIT_TYPE_HEXADECIMAL:
+	case DIGIT_TYPE_HEXADECIMAL, DIGIT_TYPE_BINARY, DIGIT_TYPE_OCTAL:
 		return INT
+	case DIGIT_TYPE_EXACT_DECIMAL:
+		return DECIMAL
 	}
 	return ILLEGAL
 }
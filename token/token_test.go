@@ -24,6 +24,9 @@ func TestLookupIdentifier(t *testing.T) {
 		{[]byte("break"), BREAK},
 		{[]byte("enum"), ENUM},
 		{[]byte("case"), CASE},
+		{[]byte("const"), CONST},
+		{[]byte("macro"), MACRO},
+		{[]byte("export"), EXPORT},
 		{[]byte("testing_var"), IDENT},
 	}
 